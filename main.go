@@ -1,11 +1,58 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		os.Exit(generate(os.Args[2:]))
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: spotinst.Provider})
 }
+
+// generate writes the configuration of the existing resources of the account
+// to the standard output. The provider is configured from the environment.
+func generate(args []string) int {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate [-resources <types>] > generated.tf\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes the Terraform configuration and the import blocks of the existing")
+		fmt.Fprintln(flags.Output(), "resources of the account, with the credentials of the environment.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	resources := flags.String("resources", "", "comma-separated resource types to generate, all supported types when empty")
+	// The flag set prints the parse errors and the usage itself.
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(flags.Output(), "unexpected arguments: %s\n", strings.Join(flags.Args(), " "))
+		flags.Usage()
+		return 2
+	}
+
+	var resourceNames []string
+	if *resources != "" {
+		resourceNames = strings.Split(*resources, ",")
+	}
+
+	if err := spotinst.Generate(context.Background(), os.Stdout, resourceNames); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
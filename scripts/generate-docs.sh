@@ -22,11 +22,15 @@ exclude_files=(
 	"docs/resources/ocean_aws_launch_spec.md"
 	"docs/resources/ocean_ecs.md"
 	"docs/resources/ocean_ecs_launch_spec.md"
+	"docs/resources/ocean_gke.md"
 	"docs/resources/ocean_gke_import.md"
 	"docs/resources/ocean_gke_launch_spec.md"
 	"docs/resources/ocean_gke_launch_spec_import.md"
 	"docs/resources/subscription.md"
 	"docs/resources/data_integration.md"
+	"docs/data-sources/elastigroup_aws.md"
+	"docs/data-sources/ocean_aws.md"
+	"docs/data-sources/ocean_aws_launch_specs.md"
 )
 
 # Check if manual changes were made to any excluded files and exit.
@@ -0,0 +1,147 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+// accountIDPrefix is the prefix of every Spotinst account ID.
+const accountIDPrefix = "act-"
+
+// accountClients caches the clients bound to the accounts set on the
+// resources, so that a single client is created per account.
+type accountClients struct {
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// forAccount returns a client that sends its requests to the given account,
+// with the credentials of the provider. The client itself is returned when the
+// account is empty.
+func (c *Client) forAccount(account string) *Client {
+	if account == "" || account == c.account {
+		return c
+	}
+
+	c.accounts.mu.Lock()
+	defer c.accounts.mu.Unlock()
+
+	if accountClient, ok := c.accounts.clients[account]; ok {
+		return accountClient
+	}
+
+	cfg := *c.sess.Config
+	cfg.Credentials = credentials.NewCredentials(&accountCredentialsProvider{
+		creds:   c.sess.Config.Credentials,
+		account: account,
+	})
+
+	accountClient := newClient(&session.Session{Config: &cfg}, c.defaultTags)
+	accountClient.account = account
+	accountClient.accounts = c.accounts
+	c.accounts.clients[account] = accountClient
+
+	log.Printf("[DEBUG] Spotinst client configured for account %s", account)
+	return accountClient
+}
+
+// accountCredentialsProvider retrieves the credentials of the provider, with
+// the account replaced by the one set on the resource.
+type accountCredentialsProvider struct {
+	creds   *credentials.Credentials
+	account string
+}
+
+func (p *accountCredentialsProvider) Retrieve() (credentials.Value, error) {
+	value, err := p.creds.Get()
+	if err != nil {
+		return value, err
+	}
+	value.Account = p.account
+	return value, nil
+}
+
+func (p *accountCredentialsProvider) String() string {
+	return "AccountCredentialsProvider"
+}
+
+// withAccountID adds the optional `account_id` attribute to the resource, and
+// wraps its operations so that they are sent to that account instead of the
+// one of the provider. Imports accept an `<account_id>:<id>` ID.
+func withAccountID(r *schema.Resource, forceNew bool) *schema.Resource {
+	r.Schema[string(commons.ResourceAccountID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: forceNew,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile("^"+accountIDPrefix),
+			fmt.Sprintf("must be a Spotinst account ID starting with %q", accountIDPrefix)),
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = withAccountMeta(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = withAccountMeta(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = withAccountMeta(r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = withAccountMeta(r.DeleteContext)
+	}
+
+	if r.Importer != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if account, id, ok := parseAccountImportID(resourceData.Id()); ok {
+				resourceData.SetId(id)
+				if err := resourceData.Set(string(commons.ResourceAccountID), account); err != nil {
+					return nil, fmt.Errorf(string(commons.FailureFieldReadPattern), string(commons.ResourceAccountID), err)
+				}
+			}
+			if importState == nil {
+				return []*schema.ResourceData{resourceData}, nil
+			}
+			return importState(ctx, resourceData, accountMeta(resourceData, meta))
+		}
+	}
+
+	return r
+}
+
+func withAccountMeta(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, resourceData, accountMeta(resourceData, meta))
+	}
+}
+
+// accountMeta returns the client bound to the account set on the resource, or
+// the meta as is when no account is set.
+func accountMeta(resourceData *schema.ResourceData, meta interface{}) interface{} {
+	spotinstClient, ok := meta.(*Client)
+	if !ok {
+		return meta
+	}
+	account, _ := resourceData.Get(string(commons.ResourceAccountID)).(string)
+	return spotinstClient.forAccount(account)
+}
+
+// parseAccountImportID splits an import ID of the form `<account_id>:<id>`.
+func parseAccountImportID(importID string) (account string, id string, ok bool) {
+	parts := strings.SplitN(importID, ":", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], accountIDPrefix) || parts[1] == "" {
+		return "", importID, false
+	}
+	return parts[0], parts[1], true
+}
@@ -11,3 +11,13 @@ const (
 	DesiredCapacity   commons.FieldName = "desired_capacity"
 	OS                commons.FieldName = "os"
 )
+
+const (
+	UpdatePolicy commons.FieldName = "update_policy"
+	ShouldRoll   commons.FieldName = "should_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	DrainingTimeout           commons.FieldName = "draining_timeout"
+)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
@@ -265,5 +266,54 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, DesiredCapacity)
+		},
+	)
+
+	fieldsMap[UpdatePolicy] = commons.NewGenericField(
+		commons.ElastigroupAzure,
+		UpdatePolicy,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldRoll): {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+
+					string(RollConfig): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(BatchSizePercentage): {
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
+								},
+
+								string(BatchMinHealthyPercentage): {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
+								},
+
+								string(DrainingTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
 	)
 }
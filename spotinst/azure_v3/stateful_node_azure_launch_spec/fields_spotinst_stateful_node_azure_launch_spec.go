@@ -22,9 +22,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			stWrapper := resourceObject.(*commons.StatefulNodeAzureV3Wrapper)
 			st := stWrapper.GetStatefulNode()
-			var value *string = nil
+			var value = ""
 			if st != nil && st.Compute != nil && st.Compute.LaunchSpecification != nil && st.Compute.LaunchSpecification.CustomData != nil {
-				value = st.Compute.LaunchSpecification.CustomData
+				customDataValue := spotinst.StringValue(st.Compute.LaunchSpecification.CustomData)
+				if isBase64Encoded(resourceData.Get(string(CustomData)).(string)) {
+					value = customDataValue
+				} else {
+					decodedCustomData, _ := base64.StdEncoding.DecodeString(customDataValue)
+					value = string(decodedCustomData)
+				}
 			}
 			if err := resourceData.Set(string(CustomData), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CustomData), err)
@@ -70,21 +70,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			stWrapper := resourceObject.(*commons.StatefulNodeAzureV3Wrapper)
 			st := stWrapper.GetStatefulNode()
-			var value []*azure.LoadBalancer = nil
 
 			if v, ok := resourceData.GetOk(string(LoadBalancer)); ok {
-				var loadBalancers []*azure.LoadBalancer
-
-				if st != nil && st.Compute != nil && st.Compute.LaunchSpecification != nil && st.Compute.LaunchSpecification.LoadBalancersConfig != nil && st.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers != nil {
-					loadBalancers = st.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers
-
-					if loadBalancers, err := expandLoadBalancers(v, loadBalancers); err != nil {
-						return err
-					} else {
-						value = loadBalancers
-					}
-
-					st.Compute.LaunchSpecification.LoadBalancersConfig.SetLoadBalancers(value)
+				if loadBalancers, err := expandLoadBalancers(v); err != nil {
+					return err
+				} else {
+					st.Compute.LaunchSpecification.SetLoadBalancersConfig(
+						(&azure.LoadBalancersConfig{}).SetLoadBalancers(loadBalancers))
 				}
 			}
 
@@ -96,21 +88,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var value []*azure.LoadBalancer = nil
 
 			if v, ok := resourceData.GetOk(string(LoadBalancer)); ok {
-				var loadBalancers []*azure.LoadBalancer
-
-				if st != nil && st.Compute != nil && st.Compute.LaunchSpecification != nil && st.Compute.LaunchSpecification.LoadBalancersConfig != nil && st.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers != nil {
-					loadBalancers = st.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers
-
-					if loadBalancers, err := expandLoadBalancers(v, loadBalancers); err != nil {
-						return err
-					} else {
-						value = loadBalancers
-					}
-
-					st.Compute.LaunchSpecification.LoadBalancersConfig.SetLoadBalancers(value)
+				if loadBalancers, err := expandLoadBalancers(v); err != nil {
+					return err
+				} else {
+					value = loadBalancers
 				}
 			}
 
+			st.Compute.LaunchSpecification.SetLoadBalancersConfig(
+				(&azure.LoadBalancersConfig{}).SetLoadBalancers(value))
+
 			return nil
 		},
 		nil,
@@ -135,8 +122,9 @@ func flattenLoadBalancers(loadBalancers []*azure.LoadBalancer) []interface{} {
 	return result
 }
 
-func expandLoadBalancers(data interface{}, loadBalancers []*azure.LoadBalancer) ([]*azure.LoadBalancer, error) {
+func expandLoadBalancers(data interface{}) ([]*azure.LoadBalancer, error) {
 	list := data.(*schema.Set).List()
+	var loadBalancers []*azure.LoadBalancer
 
 	if len(list) > 0 {
 		loadBalancers = make([]*azure.LoadBalancer, 0, len(list))
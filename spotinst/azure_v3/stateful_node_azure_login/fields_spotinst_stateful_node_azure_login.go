@@ -24,9 +24,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(Password): {
-						Type:     schema.TypeString,
-						Optional: true,
-						Computed: true,
+						Type:      schema.TypeString,
+						Optional:  true,
+						Computed:  true,
+						Sensitive: true,
 					},
 					string(SSHPublicKey): {
 						Type:     schema.TypeString,
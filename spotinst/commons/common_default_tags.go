@@ -0,0 +1,161 @@
+package commons
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultTagsProvider is implemented by the provider meta, and exposes the
+// tags configured in the `default_tags` block of the provider.
+type DefaultTagsProvider interface {
+	DefaultTags() map[string]string
+}
+
+// DefaultTags returns the default tags of the provider, or nil when none are
+// configured.
+func DefaultTags(meta interface{}) map[string]string {
+	if p, ok := meta.(DefaultTagsProvider); ok {
+		return p.DefaultTags()
+	}
+	return nil
+}
+
+// GetOkWithDefaultTags behaves like ResourceData.GetOk for a set of key/value
+// tags, with the default tags of the provider merged in. The tags of the
+// resource win when both define the same key.
+func GetOkWithDefaultTags(resourceData *schema.ResourceData, meta interface{},
+	field FieldName, keyField string, valueField string) (interface{}, bool) {
+
+	tags := resourceData.Get(string(field)).(*schema.Set)
+	defaultTags := DefaultTags(meta)
+	if len(defaultTags) == 0 {
+		return tags, tags.Len() > 0
+	}
+
+	merged := schema.NewSet(tags.F, tags.List())
+	keys := tagKeys(tags.List(), keyField)
+	for key, value := range defaultTags {
+		if _, ok := keys[key]; ok {
+			continue
+		}
+		merged.Add(map[string]interface{}{
+			keyField:   key,
+			valueField: value,
+		})
+	}
+
+	return merged, merged.Len() > 0
+}
+
+// RemoveDefaultTags filters the flattened tags read from the API, so that the
+// default tags of the provider do not show up as a diff. A default tag is only
+// kept when the resource defines the same key itself.
+func RemoveDefaultTags(resourceData *schema.ResourceData, meta interface{},
+	field FieldName, keyField string, valueField string, tags []interface{}) []interface{} {
+
+	defaultTags := DefaultTags(meta)
+	if len(defaultTags) == 0 || tags == nil {
+		return tags
+	}
+
+	var keys map[string]struct{}
+	if v, ok := resourceData.Get(string(field)).(*schema.Set); ok {
+		keys = tagKeys(v.List(), keyField)
+	}
+
+	result := make([]interface{}, 0, len(tags))
+	for _, tag := range tags {
+		m := tag.(map[string]interface{})
+		key, _ := m[keyField].(string)
+		value, _ := m[valueField].(string)
+
+		if defaultValue, ok := defaultTags[key]; ok && defaultValue == value {
+			if _, configured := keys[key]; !configured {
+				continue
+			}
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+// NewAllTagsField returns the computed field holding every tag of the resource,
+// its own tags and the default tags of the provider, as read from the API. At
+// plan time it is set to the tags the resource should have, so adding or
+// changing a default tag shows up as a change and is applied to the resource.
+func NewAllTagsField(resourceAffinity ResourceAffinity, allField FieldName,
+	field FieldName, keyField string, valueField string) *GenericField {
+
+	return NewGenericField(
+		resourceAffinity,
+		allField,
+		&schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			if !resourceDiff.NewValueKnown(string(field)) {
+				return nil
+			}
+
+			tags := make(map[string]interface{})
+			for key, value := range DefaultTags(meta) {
+				tags[key] = value
+			}
+			if v, ok := resourceDiff.Get(string(field)).(*schema.Set); ok {
+				for _, tag := range v.List() {
+					m := tag.(map[string]interface{})
+					key, _ := m[keyField].(string)
+					tags[key] = m[valueField]
+				}
+			}
+
+			if current, ok := resourceDiff.Get(string(allField)).(map[string]interface{}); ok && reflect.DeepEqual(current, tags) {
+				return nil
+			}
+			return resourceDiff.SetNew(string(allField), tags)
+		},
+	)
+}
+
+// HasTagsChange returns the change check of a tags field, which is updated when
+// either its own tags or the tags of its NewAllTagsField changed.
+func HasTagsChange(field FieldName, allField FieldName) func(resourceData *schema.ResourceData, meta interface{}) bool {
+	return func(resourceData *schema.ResourceData, meta interface{}) bool {
+		return resourceData.HasChange(string(field)) || resourceData.HasChange(string(allField))
+	}
+}
+
+// SetAllTags sets the field of NewAllTagsField to the flattened tags read from
+// the API.
+func SetAllTags(resourceData *schema.ResourceData, allField FieldName,
+	keyField string, valueField string, tags []interface{}) error {
+
+	result := make(map[string]interface{}, len(tags))
+	for _, tag := range tags {
+		m := tag.(map[string]interface{})
+		key, _ := m[keyField].(string)
+		result[key] = m[valueField]
+	}
+	if err := resourceData.Set(string(allField), result); err != nil {
+		return fmt.Errorf(string(FailureFieldReadPattern), string(allField), err)
+	}
+	return nil
+}
+
+func tagKeys(tags []interface{}, keyField string) map[string]struct{} {
+	keys := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if m, ok := tag.(map[string]interface{}); ok {
+			if key, ok := m[keyField].(string); ok {
+				keys[key] = struct{}{}
+			}
+		}
+	}
+	return keys
+}
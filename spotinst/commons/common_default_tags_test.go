@@ -0,0 +1,92 @@
+package commons
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testDefaultTagsMeta map[string]string
+
+func (m testDefaultTagsMeta) DefaultTags() map[string]string { return m }
+
+func testTagsResourceData(t *testing.T, tags []interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key":   {Type: schema.TypeString, Optional: true},
+					"value": {Type: schema.TypeString, Optional: true},
+				},
+			},
+		},
+	}, map[string]interface{}{"tags": tags})
+}
+
+func testTagsToMap(list []interface{}) map[string]string {
+	result := make(map[string]string)
+	for _, v := range list {
+		m := v.(map[string]interface{})
+		result[m["key"].(string)] = m["value"].(string)
+	}
+	return result
+}
+
+func TestGetOkWithDefaultTags(t *testing.T) {
+	meta := testDefaultTagsMeta{"owner": "platform", "cost-center": "1234"}
+
+	resourceData := testTagsResourceData(t, []interface{}{
+		map[string]interface{}{"key": "owner", "value": "team-a"},
+		map[string]interface{}{"key": "name", "value": "web"},
+	})
+
+	value, ok := GetOkWithDefaultTags(resourceData, meta, "tags", "key", "value")
+	if !ok {
+		t.Fatal("expected tags to be set")
+	}
+
+	got := testTagsToMap(value.(*schema.Set).List())
+	want := map[string]string{"owner": "team-a", "name": "web", "cost-center": "1234"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("expected tag %q to be %q, got %q", k, v, got[k])
+		}
+	}
+
+	if _, ok := GetOkWithDefaultTags(testTagsResourceData(t, nil), meta, "tags", "key", "value"); !ok {
+		t.Error("expected the default tags to be set on a resource without tags")
+	}
+	if _, ok := GetOkWithDefaultTags(testTagsResourceData(t, nil), nil, "tags", "key", "value"); ok {
+		t.Error("expected no tags without default tags")
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	meta := testDefaultTagsMeta{"owner": "platform", "cost-center": "1234"}
+
+	resourceData := testTagsResourceData(t, []interface{}{
+		map[string]interface{}{"key": "cost-center", "value": "1234"},
+	})
+
+	read := []interface{}{
+		map[string]interface{}{"key": "owner", "value": "platform"},
+		map[string]interface{}{"key": "cost-center", "value": "1234"},
+		map[string]interface{}{"key": "name", "value": "web"},
+	}
+
+	got := testTagsToMap(RemoveDefaultTags(resourceData, meta, "tags", "key", "value", read))
+	want := map[string]string{"cost-center": "1234", "name": "web"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("expected tag %q to be %q, got %q", k, v, got[k])
+		}
+	}
+}
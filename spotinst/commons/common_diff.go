@@ -0,0 +1,132 @@
+package commons
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// OnDiff runs the plan-time checks of the fields of the resource, and is meant
+// to be used as the CustomizeDiff function of the resource.
+func (res *GenericResource) OnDiff(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if res.fields == nil || res.fields.fieldsMap == nil {
+		return nil
+	}
+
+	// Run the checks in a stable order, so the same plan always reports the
+	// same error first.
+	names := make([]string, 0, len(res.fields.fieldsMap))
+	for name, field := range res.fields.fieldsMap {
+		if field.onDiff != nil {
+			names = append(names, string(name))
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := res.fields.fieldsMap[FieldName(name)]
+		log.Printf(string(ResourceFieldOnDiff), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onDiff(resourceDiff, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCapacity checks that the desired capacity of the resource is within
+// its minimum and maximum sizes. The checks are skipped for unset values and
+// for values only known after apply.
+//
+// The minimum size and the desired capacity are often optional and computed,
+// and the autoscaler changes them after apply, so their values kept from the
+// state are not checked: a lower maximum size is left to the API to enforce
+// on a capacity it set. The diff does not tell configured values from values
+// kept from the state, so they are only checked on creation, or when the plan
+// changes them.
+func ValidateCapacity(resourceDiff *schema.ResourceDiff, minField FieldName, maxField FieldName, desiredField FieldName) error {
+	maxSize, hasMax := capacityValue(resourceDiff, maxField, true)
+	minSize, hasMin := capacityValue(resourceDiff, minField, isPlanned(resourceDiff, minField))
+	if hasMin && hasMax && minSize > maxSize {
+		return fmt.Errorf("%s (%d) cannot be greater than %s (%d)",
+			minField, minSize, maxField, maxSize)
+	}
+
+	desired, ok := capacityValue(resourceDiff, desiredField, isPlanned(resourceDiff, desiredField))
+	if !ok {
+		return nil
+	}
+	if hasMin && desired < minSize {
+		return fmt.Errorf("%s (%d) cannot be lower than %s (%d)",
+			desiredField, desired, minField, minSize)
+	}
+	if hasMax && desired > maxSize {
+		return fmt.Errorf("%s (%d) cannot be greater than %s (%d)",
+			desiredField, desired, maxField, maxSize)
+	}
+	return nil
+}
+
+// capacityValue returns the value of the field when it is set, known and
+// planned.
+func capacityValue(resourceDiff *schema.ResourceDiff, field FieldName, planned bool) (int, bool) {
+	if !planned || !resourceDiff.NewValueKnown(string(field)) {
+		return 0, false
+	}
+	value, ok := resourceDiff.GetOk(string(field))
+	if !ok {
+		return 0, false
+	}
+	return value.(int), true
+}
+
+// isPlanned reports whether the value of the field comes from the plan, either
+// because the resource is created or because the plan changes the value, as
+// opposed to a value only kept from the state.
+func isPlanned(resourceDiff *schema.ResourceDiff, field FieldName) bool {
+	return resourceDiff.Id() == "" || resourceDiff.HasChange(string(field))
+}
+
+// ValidateNoOverlap checks that two lists or sets of strings, such as the
+// whitelist and blacklist of instance types, have no value in common.
+func ValidateNoOverlap(resourceDiff *schema.ResourceDiff, field FieldName, otherField FieldName) error {
+	if !resourceDiff.NewValueKnown(string(field)) || !resourceDiff.NewValueKnown(string(otherField)) {
+		return nil
+	}
+
+	values := make(map[string]struct{})
+	for _, v := range diffStrings(resourceDiff, field) {
+		values[v] = struct{}{}
+	}
+
+	var overlap []string
+	for _, v := range diffStrings(resourceDiff, otherField) {
+		if _, ok := values[v]; ok {
+			overlap = append(overlap, v)
+		}
+	}
+	if len(overlap) > 0 {
+		return fmt.Errorf("%s and %s cannot contain the same values: %v", field, otherField, overlap)
+	}
+	return nil
+}
+
+func diffStrings(resourceDiff *schema.ResourceDiff, field FieldName) []string {
+	var list []interface{}
+	switch v := resourceDiff.Get(string(field)).(type) {
+	case []interface{}:
+		list = v
+	case *schema.Set:
+		list = v.List()
+	}
+
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package commons
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testDiffResource() *schema.Resource {
+	intField := func(name FieldName) *GenericField {
+		return NewGenericField("Test", name, &schema.Schema{Type: schema.TypeInt, Optional: true, Computed: true}, nil, nil, nil, nil)
+	}
+	listField := func(name FieldName) *GenericField {
+		return NewGenericField("Test", name, &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}, nil, nil, nil, nil)
+	}
+
+	fieldsMap := map[FieldName]*GenericField{
+		"min_size": intField("min_size"),
+		"max_size": intField("max_size"),
+		"desired_capacity": intField("desired_capacity").WithDiff(
+			func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+				return ValidateCapacity(resourceDiff, "min_size", "max_size", "desired_capacity")
+			},
+		),
+		"whitelist": listField("whitelist"),
+		"blacklist": listField("blacklist").WithDiff(
+			func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+				return ValidateNoOverlap(resourceDiff, "whitelist", "blacklist")
+			},
+		),
+	}
+
+	res := &GenericResource{fields: NewGenericFields(fieldsMap)}
+	return &schema.Resource{
+		Schema:        res.GetSchemaMap(),
+		CustomizeDiff: res.OnDiff,
+	}
+}
+
+func TestGenericResource_OnDiff(t *testing.T) {
+	cases := []struct {
+		name   string
+		state  map[string]string
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "valid capacity",
+			config: map[string]interface{}{"min_size": 1, "max_size": 3, "desired_capacity": 2},
+		},
+		{
+			name:   "min above max",
+			config: map[string]interface{}{"min_size": 4, "max_size": 3},
+			err:    "min_size (4) cannot be greater than max_size (3)",
+		},
+		{
+			name:   "desired below min",
+			config: map[string]interface{}{"min_size": 2, "max_size": 3, "desired_capacity": 1},
+			err:    "desired_capacity (1) cannot be lower than min_size (2)",
+		},
+		{
+			name:   "desired above max",
+			config: map[string]interface{}{"min_size": 1, "max_size": 3, "desired_capacity": 5},
+			err:    "desired_capacity (5) cannot be greater than max_size (3)",
+		},
+		{
+			name:   "no max",
+			config: map[string]interface{}{"min_size": 1, "desired_capacity": 5},
+		},
+		{
+			name:   "desired capacity only in the state",
+			state:  map[string]string{"min_size": "1", "max_size": "10", "desired_capacity": "8"},
+			config: map[string]interface{}{"max_size": 5},
+		},
+		{
+			name:   "min size only in the state",
+			state:  map[string]string{"min_size": "6", "max_size": "10"},
+			config: map[string]interface{}{"max_size": 5},
+		},
+		{
+			name:   "desired capacity updated above max",
+			state:  map[string]string{"min_size": "1", "max_size": "10", "desired_capacity": "8"},
+			config: map[string]interface{}{"max_size": 10, "desired_capacity": 12},
+			err:    "desired_capacity (12) cannot be greater than max_size (10)",
+		},
+		{
+			name: "disjoint lists",
+			config: map[string]interface{}{
+				"whitelist": []interface{}{"m5.large"},
+				"blacklist": []interface{}{"m5.xlarge"},
+			},
+		},
+		{
+			name: "overlapping lists",
+			config: map[string]interface{}{
+				"whitelist": []interface{}{"m5.large", "m5.xlarge"},
+				"blacklist": []interface{}{"m5.xlarge"},
+			},
+			err: "whitelist and blacklist cannot contain the same values: [m5.xlarge]",
+		},
+	}
+
+	r := testDiffResource()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var state *terraform.InstanceState
+			if c.state != nil {
+				state = &terraform.InstanceState{ID: "test", Attributes: c.state}
+			}
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(c.config), nil)
+			switch {
+			case c.err == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case c.err != "" && err == nil:
+				t.Fatalf("expected error %q, got none", c.err)
+			case c.err != "" && !strings.Contains(err.Error(), c.err):
+				t.Fatalf("expected error %q, got %q", c.err, err)
+			}
+		})
+	}
+}
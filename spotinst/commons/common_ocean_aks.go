@@ -3,6 +3,7 @@ package commons
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure"
@@ -25,6 +26,12 @@ func NewOceanAKSResource(fieldsMap map[FieldName]*GenericField) *OceanAKSTerrafo
 		GenericResource: GenericResource{
 			resourceName: OceanAKSResourceName,
 			fields:       NewGenericFields(fieldsMap),
+			timeouts: &schema.ResourceTimeout{
+				// Importing an AKS cluster may take a while to complete.
+				Create: schema.DefaultTimeout(time.Hour),
+				Update: schema.DefaultTimeout(DefaultUpdateTimeout),
+				Delete: schema.DefaultTimeout(DefaultDeleteTimeout),
+			},
 		},
 	}
 }
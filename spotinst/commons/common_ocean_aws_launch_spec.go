@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	OceanAWSLaunchSpecResourceName ResourceName = "spotinst_ocean_aws_launch_spec"
+	OceanAWSLaunchSpecResourceName    ResourceName = "spotinst_ocean_aws_launch_spec"
+	OceanAWSLaunchSpecsDataSourceName ResourceName = "spotinst_ocean_aws_launch_specs"
 )
 
 var OceanAWSLaunchSpecResource *OceanAWSLaunchSpecTerraformResource
@@ -80,28 +81,32 @@ func (res *OceanAWSLaunchSpecTerraformResource) OnRead(
 
 func (res *OceanAWSLaunchSpecTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.LaunchSpec, error) {
+	meta interface{}) (bool, bool, *aws.LaunchSpec, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return false, false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	launchSpecWrapper := NewLaunchSpecWrapper()
 	hasChanged := false
+	changesRequiredRoll := false
 	for _, field := range res.fields.fieldsMap {
 		if field.onUpdate == nil {
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
+			if contains(conditionedRollFieldsAWSLaunchSpec, field.fieldNameStr) {
+				changesRequiredRoll = true
+			}
 			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
 			if err := field.onUpdate(launchSpecWrapper, resourceData, meta); err != nil {
-				return false, nil, err
+				return false, false, nil, err
 			}
 			hasChanged = true
 		}
 	}
 
-	return hasChanged, launchSpecWrapper.GetLaunchSpec(), nil
+	return hasChanged, changesRequiredRoll, launchSpecWrapper.GetLaunchSpec(), nil
 }
 
 func NewLaunchSpecWrapper() *LaunchSpecWrapper {
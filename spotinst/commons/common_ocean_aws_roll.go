@@ -0,0 +1,115 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	OceanAWSRollResourceName ResourceName = "spotinst_ocean_aws_roll"
+)
+
+var OceanAWSRollResource *OceanAWSRollTerraformResource
+
+type OceanAWSRollTerraformResource struct {
+	GenericResource
+}
+
+// OceanAWSRollSpec is the roll of an Ocean AWS cluster. The roll spec of the SDK
+// does not support respectPdb yet, so rolls are started with this one.
+type OceanAWSRollSpec struct {
+	ClusterID                 *string  `json:"clusterId,omitempty"`
+	BatchSizePercentage       *int     `json:"batchSizePercentage,omitempty"`
+	BatchMinHealthyPercentage *int     `json:"batchMinHealthyPercentage,omitempty"`
+	RespectPDB                *bool    `json:"respectPdb,omitempty"`
+	LaunchSpecIDs             []string `json:"launchSpecIds,omitempty"`
+	InstanceIDs               []string `json:"instanceIds,omitempty"`
+}
+
+type OceanAWSRollWrapper struct {
+	roll *OceanAWSRollSpec
+}
+
+// NewOceanAWSRollResource creates a new OceanAWSRoll resource
+func NewOceanAWSRollResource(fieldMap map[FieldName]*GenericField) *OceanAWSRollTerraformResource {
+	return &OceanAWSRollTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OceanAWSRollResourceName,
+			fields:       NewGenericFields(fieldMap),
+			timeouts: &schema.ResourceTimeout{
+				// The roll is awaited on create, and replacing every
+				// instance of a cluster may take a while to complete.
+				Create: schema.DefaultTimeout(2 * time.Hour),
+				Delete: schema.DefaultTimeout(DefaultDeleteTimeout),
+			},
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns a new OceanAWSRoll or an error.
+func (res *OceanAWSRollTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*OceanAWSRollSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	rollWrapper := NewOceanAWSRollWrapper()
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(rollWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return rollWrapper.GetOceanAWSRoll(), nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if it is unable to do so.
+func (res *OceanAWSRollTerraformResource) OnRead(
+	roll *OceanAWSRollSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	rollWrapper := NewOceanAWSRollWrapper()
+	rollWrapper.SetOceanAWSRoll(roll)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(rollWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Spotinst OceanAWSRoll must have a wrapper struct.
+// the wrapper struct is intended to help reflect the field states into the OceanAWSRoll object properly.
+func NewOceanAWSRollWrapper() *OceanAWSRollWrapper {
+	return &OceanAWSRollWrapper{
+		roll: &OceanAWSRollSpec{},
+	}
+}
+
+// GetOceanAWSRoll returns a wrapped OceanAWSRoll
+func (rollWrapper *OceanAWSRollWrapper) GetOceanAWSRoll() *OceanAWSRollSpec {
+	return rollWrapper.roll
+}
+
+// SetOceanAWSRoll applies roll fields to the roll wrapper.
+func (rollWrapper *OceanAWSRollWrapper) SetOceanAWSRoll(roll *OceanAWSRollSpec) {
+	rollWrapper.roll = roll
+}
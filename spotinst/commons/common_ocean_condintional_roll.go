@@ -1,5 +1,12 @@
 package commons
 
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
 var conditionedRollFieldsAWS = []string{"subnet_ids", "whitelist", "blacklist", "user_data", "image_id", "security_groups",
 	"key_name", "iam_instance_profile", "associate_public_ip_address", "load_balancers", "instance_metadata_options",
 	"ebs_optimized", "root_volume_size"}
@@ -10,6 +17,27 @@ var conditionedRollFieldsECS = []string{"subnet_ids", "whitelist", "blacklist",
 
 var conditionedRollFieldsGKE = []string{"backend_services", "root_volume_type", "whitelist"}
 
+// The launch spec fields applied only to new instances. Headrooms, tags and
+// the other scaling fields do not need a roll of the launch spec.
+var conditionedRollFieldsAWSLaunchSpec = []string{"subnet_ids", "instance_types", "user_data", "image_id",
+	"security_groups", "iam_instance_profile", "associate_public_ip_address", "block_device_mappings",
+	"root_volume_size", "labels", "taints"}
+
+var conditionedRollFieldsECSLaunchSpec = []string{"subnet_ids", "instance_types", "user_data", "image_id",
+	"security_group_ids", "iam_instance_profile", "block_device_mappings", "attributes"}
+
+var conditionedRollFieldsGKELaunchSpec = []string{"source_image", "metadata", "labels", "taints", "root_volume_type",
+	"root_volume_size", "instance_types", "shielded_instance_config", "storage", "service_account"}
+
+// The update policy attributes shared by the Ocean clusters.
+const (
+	updatePolicyField    = "update_policy"
+	shouldRollField      = "should_roll"
+	conditionedRollField = "conditioned_roll"
+	autoApplyTagsField   = "auto_apply_tags"
+	tagsField            = "tags"
+)
+
 func contains(s []string, str string) bool {
 	for _, v := range s {
 		if v == str {
@@ -19,3 +47,91 @@ func contains(s []string, str string) bool {
 
 	return false
 }
+
+// OnRollDiff sets the computed attributes that tell whether the planned update
+// of the cluster rolls it, and which of the changed fields cause the roll.
+func (res *OceanAWSTerraformResource) OnRollDiff(resourceDiff *schema.ResourceDiff, rollRequired FieldName, rollRequiredFields FieldName) error {
+	return onRollDiff(res.fields, resourceDiff, conditionedRollFieldsAWS, true, rollRequired, rollRequiredFields)
+}
+
+// OnRollDiff sets the computed attributes that tell whether the planned update
+// of the cluster rolls it, and which of the changed fields cause the roll.
+func (res *OceanECSTerraformResource) OnRollDiff(resourceDiff *schema.ResourceDiff, rollRequired FieldName, rollRequiredFields FieldName) error {
+	return onRollDiff(res.fields, resourceDiff, conditionedRollFieldsECS, true, rollRequired, rollRequiredFields)
+}
+
+// OnRollDiff sets the computed attributes that tell whether the planned update
+// of the cluster rolls it, and which of the changed fields cause the roll.
+func (res *OceanGKEImportTerraformResource) OnRollDiff(resourceDiff *schema.ResourceDiff, rollRequired FieldName, rollRequiredFields FieldName) error {
+	return onRollDiff(res.fields, resourceDiff, conditionedRollFieldsGKE, false, rollRequired, rollRequiredFields)
+}
+
+// RollImportDefaults returns the values of the roll attributes of an imported
+// cluster. The roll of the last update is unknown to the API, and none is
+// assumed, so the first plan after the import does not show it as a change.
+func RollImportDefaults(rollRequired FieldName, rollRequiredFields FieldName) map[string]interface{} {
+	return map[string]interface{}{
+		string(rollRequired):       false,
+		string(rollRequiredFields): []interface{}{},
+	}
+}
+
+// onRollDiff mirrors the roll decision made by the update of the clusters: a
+// cluster is rolled after any update when `should_roll` is set, or only after
+// the update of the conditioned fields (and of the tags, unless they are
+// applied automatically) when `conditioned_roll` is set too.
+func onRollDiff(fields *GenericFields, resourceDiff *schema.ResourceDiff, conditionedFields []string,
+	rollOnTags bool, rollRequired FieldName, rollRequiredFields FieldName) error {
+
+	if fields == nil || fields.fieldsMap == nil {
+		return nil
+	}
+
+	var changed []string
+	for _, field := range fields.fieldsMap {
+		if field.onUpdate != nil && resourceDiff.HasChange(field.fieldNameStr) {
+			changed = append(changed, field.fieldNameStr)
+		}
+	}
+
+	// Keep the attributes of the last update when the plan updates nothing,
+	// so that they do not show up as a change on their own.
+	if resourceDiff.Id() != "" && len(changed) == 0 {
+		return nil
+	}
+
+	rollFields := make([]string, 0)
+	if resourceDiff.Id() != "" {
+		shouldRoll, conditionedRoll, autoApplyTags := rollUpdatePolicy(resourceDiff)
+		if shouldRoll {
+			for _, name := range changed {
+				if !conditionedRoll || contains(conditionedFields, name) ||
+					(rollOnTags && !autoApplyTags && name == tagsField) {
+					rollFields = append(rollFields, name)
+				}
+			}
+		}
+	}
+	sort.Strings(rollFields)
+
+	if err := resourceDiff.SetNew(string(rollRequired), len(rollFields) > 0); err != nil {
+		return fmt.Errorf(string(FailureFieldReadPattern), string(rollRequired), err)
+	}
+	if err := resourceDiff.SetNew(string(rollRequiredFields), rollFields); err != nil {
+		return fmt.Errorf(string(FailureFieldReadPattern), string(rollRequiredFields), err)
+	}
+	return nil
+}
+
+func rollUpdatePolicy(resourceDiff *schema.ResourceDiff) (shouldRoll bool, conditionedRoll bool, autoApplyTags bool) {
+	list, ok := resourceDiff.Get(updatePolicyField).([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return false, false, false
+	}
+
+	m := list[0].(map[string]interface{})
+	shouldRoll, _ = m[shouldRollField].(bool)
+	conditionedRoll, _ = m[conditionedRollField].(bool)
+	autoApplyTags, _ = m[autoApplyTagsField].(bool)
+	return shouldRoll, conditionedRoll, autoApplyTags
+}
@@ -0,0 +1,151 @@
+package commons
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testRollDiffResource() *schema.Resource {
+	onUpdate := func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+		return nil
+	}
+	stringField := func(name FieldName) *GenericField {
+		return NewGenericField("Test", name, &schema.Schema{Type: schema.TypeString, Optional: true},
+			nil, nil, onUpdate, nil)
+	}
+
+	res := &OceanAWSTerraformResource{}
+	fieldsMap := map[FieldName]*GenericField{
+		"name":      stringField("name"),
+		"user_data": stringField("user_data"),
+		"update_policy": NewGenericField("Test", "update_policy", &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"should_roll":      {Type: schema.TypeBool, Required: true},
+					"conditioned_roll": {Type: schema.TypeBool, Optional: true},
+				},
+			},
+		}, nil, nil, nil, nil),
+		"roll_required": NewGenericField("Test", "roll_required", &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}, nil, nil, nil, nil).WithDiff(
+			func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+				return res.OnRollDiff(resourceDiff, "roll_required", "roll_required_fields")
+			},
+		),
+		"roll_required_fields": NewGenericField("Test", "roll_required_fields", &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}, nil, nil, nil, nil),
+	}
+	res.GenericResource = GenericResource{fields: NewGenericFields(fieldsMap)}
+
+	return &schema.Resource{
+		Schema:        res.GetSchemaMap(),
+		CustomizeDiff: res.OnDiff,
+	}
+}
+
+func TestOceanAWSTerraformResource_OnRollDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "o-12345678",
+		Attributes: map[string]string{
+			"id":                     "o-12345678",
+			"name":                   "cluster",
+			"user_data":              "echo hello",
+			"roll_required":          "false",
+			"roll_required_fields.#": "0",
+		},
+	}
+
+	cases := []struct {
+		name            string
+		config          map[string]interface{}
+		rollRequired    string
+		rollFields      string
+		rollFieldsFirst string
+	}{
+		{
+			name:         "no roll policy",
+			config:       map[string]interface{}{"name": "renamed", "user_data": "echo hello"},
+			rollRequired: "",
+		},
+		{
+			name: "roll on any change",
+			config: map[string]interface{}{
+				"name":          "renamed",
+				"user_data":     "echo hello",
+				"update_policy": []interface{}{map[string]interface{}{"should_roll": true}},
+			},
+			rollRequired:    "true",
+			rollFields:      "1",
+			rollFieldsFirst: "name",
+		},
+		{
+			name: "conditioned roll on other field",
+			config: map[string]interface{}{
+				"name":      "renamed",
+				"user_data": "echo hello",
+				"update_policy": []interface{}{map[string]interface{}{
+					"should_roll":      true,
+					"conditioned_roll": true,
+				}},
+			},
+			rollRequired: "",
+		},
+		{
+			name: "conditioned roll on conditioned field",
+			config: map[string]interface{}{
+				"name":      "cluster",
+				"user_data": "echo bye",
+				"update_policy": []interface{}{map[string]interface{}{
+					"should_roll":      true,
+					"conditioned_roll": true,
+				}},
+			},
+			rollRequired:    "true",
+			rollFields:      "1",
+			rollFieldsFirst: "user_data",
+		},
+	}
+
+	r := testRollDiffResource()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(c.config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := testDiffNew(diff, "roll_required"); got != c.rollRequired {
+				t.Fatalf("expected roll_required %q, got %q", c.rollRequired, got)
+			}
+			if got := testDiffNew(diff, "roll_required_fields.#"); got != c.rollFields {
+				t.Fatalf("expected %q roll_required_fields, got %q", c.rollFields, got)
+			}
+			if got := testDiffNew(diff, "roll_required_fields.0"); got != c.rollFieldsFirst {
+				t.Fatalf("expected roll_required_fields.0 %q, got %q", c.rollFieldsFirst, got)
+			}
+		})
+	}
+}
+
+// testDiffNew returns the planned value of a changed attribute, or an empty
+// string when the attribute is unchanged.
+func testDiffNew(diff *terraform.InstanceDiff, key string) string {
+	if diff == nil {
+		return ""
+	}
+	if attr, ok := diff.Attributes[key]; ok {
+		return attr.New
+	}
+	return ""
+}
@@ -80,28 +80,32 @@ func (res *OceanECSLaunchSpecTerraformResource) OnRead(
 
 func (res *OceanECSLaunchSpecTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *aws.ECSLaunchSpec, error) {
+	meta interface{}) (bool, bool, *aws.ECSLaunchSpec, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return false, false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	launchSpecWrapper := NewLaunchSpecECSWrapper()
 	hasChanged := false
+	changesRequiredRoll := false
 	for _, field := range res.fields.fieldsMap {
 		if field.onUpdate == nil {
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
+			if contains(conditionedRollFieldsECSLaunchSpec, field.fieldNameStr) {
+				changesRequiredRoll = true
+			}
 			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
 			if err := field.onUpdate(launchSpecWrapper, resourceData, meta); err != nil {
-				return false, nil, err
+				return false, false, nil, err
 			}
 			hasChanged = true
 		}
 	}
 
-	return hasChanged, launchSpecWrapper.GetLaunchSpec(), nil
+	return hasChanged, changesRequiredRoll, launchSpecWrapper.GetLaunchSpec(), nil
 }
 
 func NewLaunchSpecECSWrapper() *ECSLaunchSpecWrapper {
@@ -80,28 +80,32 @@ func (res *OceanGKETerraformResource) OnRead(
 
 func (res *OceanGKETerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, *gcp.Cluster, error) {
+	meta interface{}) (bool, bool, *gcp.Cluster, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
-		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+		return false, false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
 	}
 
 	clusterWrapper := NewGKEClusterWrapper()
 	hasChanged := false
+	changesRequiredRoll := false
 	for _, field := range res.fields.fieldsMap {
 		if field.onUpdate == nil {
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
+			if contains(conditionedRollFieldsGKE, field.fieldNameStr) {
+				changesRequiredRoll = true
+			}
 			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
 			if err := field.onUpdate(clusterWrapper, resourceData, meta); err != nil {
-				return false, nil, err
+				return false, false, nil, err
 			}
 			hasChanged = true
 		}
 	}
 
-	return hasChanged, clusterWrapper.GetCluster(), nil
+	return hasChanged, changesRequiredRoll, clusterWrapper.GetCluster(), nil
 }
 
 func NewGKEClusterWrapper() *GKEClusterWrapper {
@@ -0,0 +1,25 @@
+package commons
+
+import "regexp"
+
+const redactedValue = "<redacted>"
+
+var (
+	// sensitiveJSONValuePattern matches the values of the JSON keys that hold
+	// credentials, in both their API (camelCase) and Terraform (snake_case)
+	// spelling.
+	sensitiveJSONValuePattern = regexp.MustCompile(
+		`("(?i:token|acl_?token|secret_?key|password)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// authorizationHeaderPattern matches the bearer token of dumped HTTP
+	// requests.
+	authorizationHeaderPattern = regexp.MustCompile(`(?i)(Authorization:\s*Bearer\s+)\S+`)
+)
+
+// Redact replaces the credentials found in the given log message, either as
+// JSON values or as an HTTP authorization header, with a placeholder.
+func Redact(s string) string {
+	s = sensitiveJSONValuePattern.ReplaceAllString(s, `${1}"`+redactedValue+`"`)
+	s = authorizationHeaderPattern.ReplaceAllString(s, "${1}"+redactedValue)
+	return s
+}
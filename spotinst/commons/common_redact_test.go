@@ -0,0 +1,49 @@
+package commons
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToJson_Redact(t *testing.T) {
+	object := map[string]interface{}{
+		"integration_rancher": map[string]interface{}{
+			"access_key": "access",
+			"secret_key": "secret",
+		},
+		"kubernetes": map[string]interface{}{"token": "k8s-token"},
+		"nomad":      map[string]interface{}{"aclToken": "nomad-token"},
+		"login":      map[string]interface{}{"password": `pass"word`},
+		"metadataOptions": map[string]interface{}{
+			"httpTokens": "required",
+		},
+	}
+
+	json, err := ToJson(object)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, secret := range []string{"k8s-token", "nomad-token", `pass\"word`, `"secret"`} {
+		if strings.Contains(json, secret) {
+			t.Errorf("expected %s to be redacted:\n%s", secret, json)
+		}
+	}
+	for _, value := range []string{`"access"`, `"required"`} {
+		if !strings.Contains(json, value) {
+			t.Errorf("expected %s to be kept:\n%s", value, json)
+		}
+	}
+}
+
+func TestRedact_AuthorizationHeader(t *testing.T) {
+	dump := "GET /aws/ec2/group HTTP/1.1\r\nAuthorization: Bearer abc123\r\nAccept: application/json\r\n"
+
+	redacted := Redact(dump)
+	if strings.Contains(redacted, "abc123") {
+		t.Errorf("expected the bearer token to be redacted:\n%s", redacted)
+	}
+	if !strings.Contains(redacted, "Accept: application/json") {
+		t.Errorf("expected the other headers to be kept:\n%s", redacted)
+	}
+}
@@ -21,11 +21,19 @@ type (
 	onFieldRead    func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error
 	onFieldCreate  func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error
 	onFieldUpdate  func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error
+	onFieldDiff    func(resourceDiff *schema.ResourceDiff, meta interface{}) error
+)
+
+const (
+	DefaultCreateTimeout = 20 * time.Minute
+	DefaultUpdateTimeout = 20 * time.Minute
+	DefaultDeleteTimeout = 20 * time.Minute
 )
 
 type GenericResource struct {
 	fields       *GenericFields
 	resourceName ResourceName
+	timeouts     *schema.ResourceTimeout
 }
 
 type GenericField struct {
@@ -37,6 +45,7 @@ type GenericField struct {
 	onCreate         onFieldCreate
 	onUpdate         onFieldUpdate
 	hasChangeCustom  hasFieldChange
+	onDiff           onFieldDiff
 }
 
 type GenericFields struct {
@@ -83,6 +92,13 @@ func (field *GenericField) GetSchema() *schema.Schema {
 	return field.schema
 }
 
+// WithDiff sets a hook called at plan time to validate the field against the
+// other fields of the resource, and returns the field.
+func (field *GenericField) WithDiff(onDiff onFieldDiff) *GenericField {
+	field.onDiff = onDiff
+	return field
+}
+
 func (field *GenericField) hasFieldChange(resourceData *schema.ResourceData, meta interface{}) bool {
 	if field.hasChangeCustom != nil {
 		return field.hasChangeCustom(resourceData, meta)
@@ -105,14 +121,67 @@ func (res *GenericResource) GetSchemaMap() map[string]*schema.Schema {
 	return res.fields.schemaMap
 }
 
+// GetDataSourceSchemaMap returns a copy of the resource schema in which every
+// attribute is computed, so a data source can expose the same attributes as the
+// resource and populate them through the same onRead handlers.
+func (res *GenericResource) GetDataSourceSchemaMap() map[string]*schema.Schema {
+	schemaMap := res.GetSchemaMap()
+	if schemaMap == nil {
+		return nil
+	}
+	return dataSourceSchemaMap(schemaMap)
+}
+
+func dataSourceSchemaMap(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(resourceSchema))
+	for k, v := range resourceSchema {
+		result[k] = dataSourceSchema(v)
+	}
+	return result
+}
+
+func dataSourceSchema(resourceSchema *schema.Schema) *schema.Schema {
+	result := &schema.Schema{
+		Type:        resourceSchema.Type,
+		Computed:    true,
+		Description: resourceSchema.Description,
+		Set:         resourceSchema.Set,
+		Sensitive:   resourceSchema.Sensitive,
+	}
+
+	switch elem := resourceSchema.Elem.(type) {
+	case *schema.Resource:
+		result.Elem = &schema.Resource{Schema: dataSourceSchemaMap(elem.Schema)}
+	case *schema.Schema:
+		result.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return result
+}
+
 func (res *GenericResource) GetName() string {
 	return string(res.resourceName)
 }
 
+// GetTimeouts returns the default create, update and delete timeouts of the
+// resource. They are exposed to users through the standard `timeouts` block.
+func (res *GenericResource) GetTimeouts() *schema.ResourceTimeout {
+	if res.timeouts != nil {
+		return res.timeouts
+	}
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(DefaultCreateTimeout),
+		Update: schema.DefaultTimeout(DefaultUpdateTimeout),
+		Delete: schema.DefaultTimeout(DefaultDeleteTimeout),
+	}
+}
+
+// ToJson returns the indented JSON representation of the object for logging
+// purposes, with its credentials redacted.
 func ToJson(object interface{}) (string, error) {
 	if bytes, err := json.MarshalIndent(object, "", "  "); err != nil {
 		return "", err
 	} else {
-		return string(bytes), nil
+		return Redact(string(bytes)), nil
 	}
 }
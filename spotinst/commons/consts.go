@@ -16,6 +16,17 @@ const (
 	ProviderToken        FieldName = "token"
 	ProviderAccount      FieldName = "account"
 	ProviderFeatureFlags FieldName = "feature_flags"
+	ProviderProfile      FieldName = "profile"
+	ProviderCredsFile    FieldName = "credentials_file"
+	ProviderEndpoint     FieldName = "endpoint"
+	ProviderCABundle     FieldName = "ca_bundle"
+	ProviderHTTPProxy    FieldName = "http_proxy"
+	ProviderMaxRetries   FieldName = "max_retries"
+	ProviderMaxBackoff   FieldName = "max_backoff"
+	ProviderDefaultTags  FieldName = "default_tags"
+	ProviderTags         FieldName = "tags"
+
+	ResourceAccountID FieldName = "account_id"
 
 	Subscription                         ResourceAffinity = "Subscription"
 	ElastigroupAWSBeanstalk              ResourceAffinity = "ElastigroupAWSBeanstalk"
@@ -33,6 +44,8 @@ const (
 
 	OceanAWSExtendedResourceDefinition ResourceAffinity = "Ocean_AWS_Extended_Resource_Definition"
 
+	OceanAWSRoll ResourceAffinity = "Ocean_AWS_Roll"
+
 	OceanGKE                          ResourceAffinity = "Ocean_GKE"
 	OceanGKEImport                    ResourceAffinity = "Ocean_GKE_Import"
 	OceanGKEImportScheduling          ResourceAffinity = "Ocean_GKE_Import_Scheduling"
@@ -108,6 +121,7 @@ const (
 	ElastigroupGCPScalingPolicies     ResourceAffinity = "Elastigroup_GCP_Scaling_Policies"
 	ElastigroupGCPScheduledTask       ResourceAffinity = "Elastigroup_GCP_Scheduled_Task"
 	ElastigroupGCPStrategy            ResourceAffinity = "Elastigroup_GCP_Strategy"
+	ElastigroupGCPUpdatePolicy        ResourceAffinity = "Elastigroup_GCP_Update_Policy"
 
 	ElastigroupGKE ResourceAffinity = "Elastigroup_GKE"
 
@@ -167,6 +181,7 @@ const (
 	ResourceFieldOnCreate LogFormat = "onCreate() -> %s -> %s"
 	ResourceFieldOnUpdate LogFormat = "onUpdate() -> %s -> %s"
 	ResourceFieldOnMerge  LogFormat = "onMerge() -> %s -> %s"
+	ResourceFieldOnDiff   LogFormat = "onDiff() -> %s -> %s"
 
 	ResourceOnDelete LogFormat = "onDelete() -> %s -> started for %s..."
 	ResourceOnUpdate LogFormat = "onUpdate() -> %s -> started for %s..."
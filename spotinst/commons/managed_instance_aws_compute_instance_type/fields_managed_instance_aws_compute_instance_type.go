@@ -141,6 +141,22 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			if !resourceDiff.NewValueKnown(string(PreferredType)) || !resourceDiff.NewValueKnown(string(Types)) {
+				return nil
+			}
+			preferredType, ok := resourceDiff.GetOk(string(PreferredType))
+			if !ok {
+				return nil
+			}
+			for _, instanceType := range resourceDiff.Get(string(Types)).([]interface{}) {
+				if instanceType == preferredType {
+					return nil
+				}
+			}
+			return fmt.Errorf("%s %q must be one of the %s", PreferredType, preferredType.(string), Types)
+		},
 	)
 
 }
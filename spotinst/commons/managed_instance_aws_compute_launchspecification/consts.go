@@ -18,6 +18,7 @@ const (
 	ImageID             commons.FieldName = "image_id"
 	KeyPair             commons.FieldName = "key_pair"
 	Tags                commons.FieldName = "tags"
+	TagsAll             commons.FieldName = "tags_all"
 	UserData            commons.FieldName = "user_data"
 	ShutdownScript      commons.FieldName = "shutdown_script"
 	CPUCredits          commons.FieldName = "cpu_credits"
@@ -517,6 +517,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				tags := managedInstance.Compute.LaunchSpecification.Tags
 				result = flattenTags(tags)
 			}
+			if err := commons.SetAllTags(resourceData, TagsAll, string(TagKey), string(TagValue), result); err != nil {
+				return err
+			}
+			result = commons.RemoveDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue), result)
 			if result != nil {
 				if err := resourceData.Set(string(Tags), result); err != nil {
 					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
@@ -527,7 +531,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
 			managedInstance := miWrapper.GetManagedInstance()
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -540,7 +544,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
 			managedInstance := miWrapper.GetManagedInstance()
 			var tagsToAdd []*aws.Tag = nil
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -550,9 +554,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			managedInstance.Compute.LaunchSpecification.SetTags(tagsToAdd)
 			return nil
 		},
-		nil,
+		commons.HasTagsChange(Tags, TagsAll),
 	)
 
+	fieldsMap[TagsAll] = commons.NewAllTagsField(commons.ManagedInstanceAWSLaunchSpecification, TagsAll, Tags, string(TagKey), string(TagValue))
+
 	fieldsMap[BlockDeviceMappings] = commons.NewGenericField(
 		commons.ManagedInstanceAWSLaunchSpecification,
 		BlockDeviceMappings,
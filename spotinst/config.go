@@ -1,8 +1,13 @@
 package spotinst
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
@@ -16,13 +21,16 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/stateful"
 	"github.com/spotinst/spotinst-sdk-go/service/subscription"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/featureflag"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/version"
 	stdlog "log"
 	"strings"
+	"time"
 )
 
 var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
@@ -30,11 +38,37 @@ var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
 	"providers/spotinst/index.html\nfor more information on providing " +
 	"credentials for Spotinst Provider.")
 
+const (
+	// EnvVarEndpoint is the name of the environment variable that overrides
+	// the base URL of the Spotinst API.
+	EnvVarEndpoint = "SPOTINST_ENDPOINT"
+
+	// EnvVarCABundle is the name of the environment variable holding the path
+	// of a custom CA bundle.
+	EnvVarCABundle = "SPOTINST_CA_BUNDLE"
+
+	// EnvVarHTTPProxy is the name of the environment variable holding the URL
+	// of the HTTP proxy to use.
+	EnvVarHTTPProxy = "SPOTINST_HTTP_PROXY"
+)
+
 type Config struct {
 	Token        string
 	Account      string
 	FeatureFlags string
 
+	// Profile and CredentialsFile select the profile of the Spotinst
+	// credentials file to load.
+	Profile         string
+	CredentialsFile string
+
+	Endpoint     string
+	CABundle     string
+	HTTPProxy    string
+	MaxRetries   int
+	MaxBackoff   int // seconds
+	DefaultTags  map[string]string
+
 	terraformVersion string
 }
 
@@ -48,6 +82,34 @@ type Client struct {
 	managedInstance managedinstance.Service
 	dataIntegration dataintegration.Service
 	statefulNode    stateful.Service
+
+	// api sends requests to the endpoints that are not covered by the
+	// services above yet.
+	api *client.Client
+
+	// defaultTags holds the tags of the `default_tags` provider block.
+	defaultTags map[string]string
+
+	// sess is the session the services above were created with, and accounts
+	// caches the clients bound to the accounts set on the resources. account
+	// is empty for the client of the provider account.
+	sess     *session.Session
+	accounts *accountClients
+	account  string
+}
+
+// DefaultTags returns the tags of the `default_tags` provider block, which are
+// merged into the tags of every taggable resource.
+func (c *Client) DefaultTags() map[string]string {
+	return c.defaultTags
+}
+
+// withoutDefaultTags returns a copy of the client that does not filter the
+// default tags out of the resources it reads, for use by data sources.
+func (c *Client) withoutDefaultTags() *Client {
+	clone := *c
+	clone.defaultTags = nil
+	return &clone
 }
 
 // Client configures and returns a fully initialized Spotinst client.
@@ -61,7 +123,14 @@ func (c *Config) Client() (*Client, diag.Diagnostics) {
 	}
 
 	// Create a new client.
-	client := &Client{
+	spotinstClient := newClient(sess, c.DefaultTags)
+
+	stdlog.Println("[INFO] Spotinst client configured")
+	return spotinstClient, nil
+}
+
+func newClient(sess *session.Session, defaultTags map[string]string) *Client {
+	return &Client{
 		elastigroup:     elastigroup.New(sess),
 		healthCheck:     healthcheck.New(sess),
 		subscription:    subscription.New(sess),
@@ -71,10 +140,11 @@ func (c *Config) Client() (*Client, diag.Diagnostics) {
 		managedInstance: managedinstance.New(sess),
 		dataIntegration: dataintegration.New(sess),
 		statefulNode:    stateful.New(sess),
+		api:             client.New(sess.Config),
+		defaultTags:     defaultTags,
+		sess:            sess,
+		accounts:        &accountClients{clients: make(map[string]*Client)},
 	}
-
-	stdlog.Println("[INFO] Spotinst client configured")
-	return client, nil
 }
 
 func (c *Config) getSession() (*session.Session, error) {
@@ -82,10 +152,23 @@ func (c *Config) getSession() (*session.Session, error) {
 
 	// HTTP options.
 	{
-		config.WithHTTPClient(cleanhttp.DefaultPooledClient())
+		httpClient, err := c.getHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+		config.WithHTTPClient(httpClient)
 		config.WithUserAgent(c.getUserAgent())
 	}
 
+	// Endpoint.
+	if c.Endpoint != "" {
+		if _, err := url.Parse(c.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %v", c.Endpoint, err)
+		}
+		stdlog.Printf("[DEBUG] Using Spotinst API endpoint: %s", c.Endpoint)
+		config.WithBaseURL(c.Endpoint)
+	}
+
 	// Credentials.
 	{
 		v, err := c.getCredentials()
@@ -98,13 +181,59 @@ func (c *Config) getSession() (*session.Session, error) {
 	// Logging.
 	{
 		config.WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
-			stdlog.Printf(fmt.Sprintf("[DEBUG] [spotinst-sdk-go] %s", format), args...)
+			// The SDK dumps whole requests, including the authorization
+			// header and the credentials of the integrations.
+			stdlog.Printf("[DEBUG] [spotinst-sdk-go] %s", commons.Redact(fmt.Sprintf(format, args...)))
 		}))
 	}
 
 	return session.New(config), nil
 }
 
+func (c *Config) getHTTPClient() (*http.Client, error) {
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	if c.CABundle != "" {
+		pem, err := os.ReadFile(c.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle %q: %v", c.CABundle, err)
+		}
+
+		// Trust the system roots as well, so a bundle that only holds the
+		// certificate of a proxy does not break the other connections.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates found in CA bundle %q", c.CABundle)
+		}
+
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP proxy %q: %v", c.HTTPProxy, err)
+		}
+		stdlog.Printf("[DEBUG] Using HTTP proxy: %s", proxyURL.Redacted())
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	maxBackoff := c.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+	httpClient.Transport = newRetryTransport(transport, c.MaxRetries, time.Duration(maxBackoff)*time.Second)
+
+	return httpClient, nil
+}
+
 func (c *Config) getUserAgent() string {
 	agents := []struct {
 		Product string
@@ -146,20 +275,45 @@ func (c *Config) getCredentials() (*credentials.Credentials, error) {
 			},
 		}
 	}
+
+	file := &credentials.FileProvider{
+		Profile:  c.Profile,
+		Filename: c.CredentialsFile,
+	}
+
+	// A profile selected in the provider block takes precedence over the
+	// token and account, which may have been sourced from the environment. A
+	// profile selected in the environment is read by the file provider last.
+	if c.Profile != "" || c.CredentialsFile != "" {
+		if _, err := file.Retrieve(); err != nil {
+			return nil, fmt.Errorf("failed to load profile %q from credentials file %q: %v",
+				file.Profile, file.Filename, err)
+		}
+		providers = append(providers, file)
+	}
 	if static != nil {
 		providers = append(providers, static)
 	}
 
-	providers = append(providers,
-		new(credentials.EnvProvider),
-		new(credentials.FileProvider))
+	providers = append(providers, new(credentials.EnvProvider))
+	if c.Profile == "" && c.CredentialsFile == "" {
+		providers = append(providers, file)
+	}
 
 	creds := credentials.NewChainCredentials(providers...)
 
-	if _, err := creds.Get(); err != nil {
+	v, err := creds.Get()
+	if err != nil {
 		stdlog.Printf("[ERROR] Failed to instantiate Spotinst client: %v", err)
 		return nil, ErrNoValidCredentials
 	}
 
+	if v.ProviderName == credentials.FileCredentialsProviderName {
+		stdlog.Printf("[DEBUG] Using Spotinst credentials from %s (profile %q, file %q)",
+			v.ProviderName, file.Profile, file.Filename)
+	} else {
+		stdlog.Printf("[DEBUG] Using Spotinst credentials from %s", v.ProviderName)
+	}
+
 	return creds, nil
 }
@@ -0,0 +1,109 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_aws"
+)
+
+func dataSourceSpotinstElastigroupAWS() *schema.Resource {
+	setupElastigroupResource()
+
+	dataSourceSchema := commons.ElastigroupResource.GetDataSourceSchemaMap()
+	dataSourceSchema["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", string(elastigroup_aws.Name)},
+	}
+	dataSourceSchema[string(elastigroup_aws.Name)] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", string(elastigroup_aws.Name)},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstElastigroupAWSRead,
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceSpotinstElastigroupAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var group *aws.Group
+	var err error
+	if id := resourceData.Get("id").(string); id != "" {
+		log.Printf(string(commons.ResourceOnRead),
+			commons.ElastigroupResource.GetName(), id)
+		group, err = readElastigroupAWSByID(ctx, id, meta.(*Client))
+	} else {
+		name := resourceData.Get(string(elastigroup_aws.Name)).(string)
+		log.Printf(string(commons.ResourceOnRead),
+			commons.ElastigroupResource.GetName(), name)
+		group, err = readElastigroupAWSByName(ctx, name, meta.(*Client))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(group.ID))
+
+	if err := commons.ElastigroupResource.OnRead(group, resourceData, meta.(*Client).withoutDefaultTags()); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("===> Elastigroup read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func readElastigroupAWSByID(ctx context.Context, id string, spotinstClient *Client) (*aws.Group, error) {
+	input := &aws.ReadGroupInput{GroupID: spotinst.String(id)}
+	resp, err := spotinstClient.elastigroup.CloudProviderAWS().Read(ctx, input)
+	if err != nil {
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeGroupNotFound {
+					return nil, fmt.Errorf("no Elastigroup found with ID %q", id)
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to read group: %s", err)
+	}
+	if resp.Group == nil {
+		return nil, fmt.Errorf("no Elastigroup found with ID %q", id)
+	}
+	return resp.Group, nil
+}
+
+func readElastigroupAWSByName(ctx context.Context, name string, spotinstClient *Client) (*aws.Group, error) {
+	resp, err := spotinstClient.elastigroup.CloudProviderAWS().List(ctx, &aws.ListGroupsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %s", err)
+	}
+
+	var matches []*aws.Group
+	for _, group := range resp.Groups {
+		if spotinst.StringValue(group.Name) == name {
+			matches = append(matches, group)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no Elastigroup found with name %q", name)
+	case 1:
+		// The list response omits some of the group configuration, so read
+		// the group again to get its full representation.
+		return readElastigroupAWSByID(ctx, spotinst.StringValue(matches[0].ID), spotinstClient)
+	default:
+		return nil, fmt.Errorf("found %d Elastigroups with name %q, use the group ID instead", len(matches), name)
+	}
+}
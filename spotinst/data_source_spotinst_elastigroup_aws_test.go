@@ -0,0 +1,59 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.ElastigroupAWSResourceName), name)
+}
+
+func TestAccSpotinstElastigroupAWSDataSource_Baseline(t *testing.T) {
+	groupName := "test-acc-eg-data-source"
+	resourceName := createElastigroupResourceName(groupName)
+	dataSourceByIDName := createElastigroupDataSourceName("by_id")
+	dataSourceByNameName := createElastigroupDataSourceName("by_name")
+
+	var group aws.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupTerraform(&GroupConfigMetadata{
+					groupName: groupName,
+				}) + fmt.Sprintf(testElastigroupDataSourceConfig, resourceName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "availability_zones.#", resourceName, "availability_zones.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "desired_capacity", resourceName, "desired_capacity"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "max_size", resourceName, "max_size"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "min_size", resourceName, "min_size"),
+				),
+			},
+		},
+	})
+}
+
+const testElastigroupDataSourceConfig = `
+data "` + string(commons.ElastigroupAWSResourceName) + `" "by_id" {
+	provider = "aws"
+	id       = %[1]v.id
+}
+
+data "` + string(commons.ElastigroupAWSResourceName) + `" "by_name" {
+	provider   = "aws"
+	name       = "%[2]v"
+	depends_on = [%[1]v]
+}
+`
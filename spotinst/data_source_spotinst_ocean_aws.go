@@ -0,0 +1,115 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/ocean_aws"
+)
+
+func dataSourceSpotinstOceanAWS() *schema.Resource {
+	setupClusterAWSResource()
+
+	lookupFields := []string{"id", string(ocean_aws.ControllerClusterID), string(ocean_aws.Name)}
+
+	dataSourceSchema := commons.OceanAWSResource.GetDataSourceSchemaMap()
+	for _, field := range lookupFields {
+		dataSourceSchema[field] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: lookupFields,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanAWSRead,
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceSpotinstOceanAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var cluster *aws.Cluster
+	var err error
+	if id := resourceData.Get("id").(string); id != "" {
+		log.Printf(string(commons.ResourceOnRead),
+			commons.OceanAWSResource.GetName(), id)
+		cluster, err = readOceanAWSClusterByID(ctx, id, meta.(*Client))
+	} else if controllerID := resourceData.Get(string(ocean_aws.ControllerClusterID)).(string); controllerID != "" {
+		log.Printf(string(commons.ResourceOnRead),
+			commons.OceanAWSResource.GetName(), controllerID)
+		cluster, err = findOceanAWSCluster(ctx, meta.(*Client), "controller ID", controllerID, func(c *aws.Cluster) bool {
+			return spotinst.StringValue(c.ControllerClusterID) == controllerID
+		})
+	} else {
+		name := resourceData.Get(string(ocean_aws.Name)).(string)
+		log.Printf(string(commons.ResourceOnRead),
+			commons.OceanAWSResource.GetName(), name)
+		cluster, err = findOceanAWSCluster(ctx, meta.(*Client), "name", name, func(c *aws.Cluster) bool {
+			return spotinst.StringValue(c.Name) == name
+		})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(cluster.ID))
+
+	if err := commons.OceanAWSResource.OnRead(cluster, resourceData, meta.(*Client).withoutDefaultTags()); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("===> Cluster read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func readOceanAWSClusterByID(ctx context.Context, id string, spotinstClient *Client) (*aws.Cluster, error) {
+	input := &aws.ReadClusterInput{ClusterID: spotinst.String(id)}
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ReadCluster(ctx, input)
+	if err != nil {
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeClusterNotFound {
+					return nil, fmt.Errorf("no Ocean cluster found with ID %q", id)
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to read cluster: %s", err)
+	}
+	if resp.Cluster == nil {
+		return nil, fmt.Errorf("no Ocean cluster found with ID %q", id)
+	}
+	return resp.Cluster, nil
+}
+
+func findOceanAWSCluster(ctx context.Context, spotinstClient *Client, attribute, value string, match func(*aws.Cluster) bool) (*aws.Cluster, error) {
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ListClusters(ctx, &aws.ListClustersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %s", err)
+	}
+
+	var matches []*aws.Cluster
+	for _, cluster := range resp.Clusters {
+		if match(cluster) {
+			matches = append(matches, cluster)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no Ocean cluster found with %s %q", attribute, value)
+	case 1:
+		// The list response omits some of the cluster configuration, so read
+		// the cluster again to get its full representation.
+		return readOceanAWSClusterByID(ctx, spotinst.StringValue(matches[0].ID), spotinstClient)
+	default:
+		return nil, fmt.Errorf("found %d Ocean clusters with %s %q, use the cluster ID instead", len(matches), attribute, value)
+	}
+}
@@ -0,0 +1,176 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/ocean_aws_launch_spec"
+)
+
+const (
+	oceanAWSLaunchSpecsNameRegex commons.FieldName = "name_regex"
+	oceanAWSLaunchSpecsLabels    commons.FieldName = "labels"
+	oceanAWSLaunchSpecsList      commons.FieldName = "launch_specs"
+	oceanAWSLaunchSpecsID        commons.FieldName = "id"
+)
+
+func dataSourceSpotinstOceanAWSLaunchSpecs() *schema.Resource {
+	setupOceanAWSLaunchSpecResource()
+
+	// Reuse the launch spec resource schema so the exported attributes
+	// always match the ones of spotinst_ocean_aws_launch_spec.
+	launchSpecSchema := commons.OceanAWSLaunchSpecResource.GetDataSourceSchemaMap()
+	launchSpecFields := []commons.FieldName{
+		ocean_aws_launch_spec.Name,
+		ocean_aws_launch_spec.Labels,
+		ocean_aws_launch_spec.Taints,
+		ocean_aws_launch_spec.InstanceTypes,
+		ocean_aws_launch_spec.SubnetIDs,
+		ocean_aws_launch_spec.Tags,
+	}
+
+	elemSchema := map[string]*schema.Schema{
+		string(oceanAWSLaunchSpecsID): {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for _, field := range launchSpecFields {
+		elemSchema[string(field)] = launchSpecSchema[string(field)]
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanAWSLaunchSpecsRead,
+
+		Schema: map[string]*schema.Schema{
+			string(ocean_aws_launch_spec.OceanID): {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			string(oceanAWSLaunchSpecsNameRegex): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			string(oceanAWSLaunchSpecsLabels): {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(oceanAWSLaunchSpecsList): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: elemSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOceanAWSLaunchSpecsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oceanID := resourceData.Get(string(ocean_aws_launch_spec.OceanID)).(string)
+	log.Printf(string(commons.ResourceOnRead),
+		string(commons.OceanAWSLaunchSpecsDataSourceName), oceanID)
+
+	input := &aws.ListLaunchSpecsInput{OceanID: spotinst.String(oceanID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListLaunchSpecs(ctx, input)
+	if err != nil {
+		return diag.Errorf("failed to list launch specs of cluster %q: %s", oceanID, err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if value, ok := resourceData.GetOk(string(oceanAWSLaunchSpecsNameRegex)); ok {
+		nameRegex = regexp.MustCompile(value.(string))
+	}
+
+	labels := make(map[string]string)
+	for key, value := range resourceData.Get(string(oceanAWSLaunchSpecsLabels)).(map[string]interface{}) {
+		labels[key] = value.(string)
+	}
+
+	launchSpecs := make([]interface{}, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		if nameRegex != nil && !nameRegex.MatchString(spotinst.StringValue(launchSpec.Name)) {
+			continue
+		}
+		if !oceanAWSLaunchSpecHasLabels(launchSpec, labels) {
+			continue
+		}
+		launchSpecs = append(launchSpecs, flattenOceanAWSLaunchSpecSummary(launchSpec))
+	}
+
+	if err := resourceData.Set(string(oceanAWSLaunchSpecsList), launchSpecs); err != nil {
+		return diag.FromErr(fmt.Errorf(string(commons.FailureFieldReadPattern), string(oceanAWSLaunchSpecsList), err))
+	}
+
+	resourceData.SetId(oceanID)
+	log.Printf("===> Launch specs read successfully: %s (%d found) <===", oceanID, len(launchSpecs))
+	return nil
+}
+
+// oceanAWSLaunchSpecHasLabels reports whether the launch spec carries every
+// one of the given labels with a matching value.
+func oceanAWSLaunchSpecHasLabels(launchSpec *aws.LaunchSpec, labels map[string]string) bool {
+	for key, value := range labels {
+		found := false
+		for _, label := range launchSpec.Labels {
+			if spotinst.StringValue(label.Key) == key && spotinst.StringValue(label.Value) == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenOceanAWSLaunchSpecSummary(launchSpec *aws.LaunchSpec) map[string]interface{} {
+	labels := make([]interface{}, 0, len(launchSpec.Labels))
+	for _, label := range launchSpec.Labels {
+		labels = append(labels, map[string]interface{}{
+			string(ocean_aws_launch_spec.LabelKey):   spotinst.StringValue(label.Key),
+			string(ocean_aws_launch_spec.LabelValue): spotinst.StringValue(label.Value),
+		})
+	}
+
+	taints := make([]interface{}, 0, len(launchSpec.Taints))
+	for _, taint := range launchSpec.Taints {
+		taints = append(taints, map[string]interface{}{
+			string(ocean_aws_launch_spec.TaintKey):   spotinst.StringValue(taint.Key),
+			string(ocean_aws_launch_spec.TaintValue): spotinst.StringValue(taint.Value),
+			string(ocean_aws_launch_spec.Effect):     spotinst.StringValue(taint.Effect),
+		})
+	}
+
+	tags := make([]interface{}, 0, len(launchSpec.Tags))
+	for _, tag := range launchSpec.Tags {
+		tags = append(tags, map[string]interface{}{
+			string(ocean_aws_launch_spec.TagKey):   spotinst.StringValue(tag.Key),
+			string(ocean_aws_launch_spec.TagValue): spotinst.StringValue(tag.Value),
+		})
+	}
+
+	return map[string]interface{}{
+		string(oceanAWSLaunchSpecsID):               spotinst.StringValue(launchSpec.ID),
+		string(ocean_aws_launch_spec.Name):          spotinst.StringValue(launchSpec.Name),
+		string(ocean_aws_launch_spec.Labels):        labels,
+		string(ocean_aws_launch_spec.Taints):        taints,
+		string(ocean_aws_launch_spec.InstanceTypes): launchSpec.InstanceTypes,
+		string(ocean_aws_launch_spec.SubnetIDs):     launchSpec.SubnetIDs,
+		string(ocean_aws_launch_spec.Tags):          tags,
+	}
+}
@@ -0,0 +1,63 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSLaunchSpecsDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OceanAWSLaunchSpecsDataSourceName), name)
+}
+
+func TestAccSpotinstOceanAWSLaunchSpecsDataSource_Baseline(t *testing.T) {
+	oceanID := "o-323b5842"
+	resourceName := createOceanAWSLaunchSpecResourceOceanID(oceanID)
+	dataSourceByLabelsName := createOceanAWSLaunchSpecsDataSourceName("by_labels")
+	dataSourceByNameRegexName := createOceanAWSLaunchSpecsDataSourceName("by_name_regex")
+
+	var launchSpec aws.LaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSLaunchSpecDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSLaunchSpecTerraform(&LaunchSpecConfigMetadata{
+					oceanID: oceanID,
+				}, testBaselineOceanAWSLaunchSpecConfig_Create) + fmt.Sprintf(testOceanAWSLaunchSpecsDataSourceConfig, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSLaunchSpecExists(&launchSpec, resourceName),
+					resource.TestCheckResourceAttr(dataSourceByLabelsName, "launch_specs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceByLabelsName, "launch_specs.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceByLabelsName, "launch_specs.0.labels.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceByLabelsName, "launch_specs.0.taints.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceByLabelsName, "launch_specs.0.instance_types.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceByLabelsName, "launch_specs.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceByNameRegexName, "launch_specs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testOceanAWSLaunchSpecsDataSourceConfig = `
+data "` + string(commons.OceanAWSLaunchSpecsDataSourceName) + `" "by_labels" {
+	provider = "aws"
+	ocean_id = %[1]v.ocean_id
+
+	labels = {
+		"label key" = "label value"
+	}
+}
+
+data "` + string(commons.OceanAWSLaunchSpecsDataSourceName) + `" "by_name_regex" {
+	provider   = "aws"
+	ocean_id   = %[1]v.ocean_id
+	name_regex = "^no-such-launch-spec$"
+}
+`
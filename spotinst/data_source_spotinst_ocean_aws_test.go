@@ -0,0 +1,70 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OceanAWSResourceName), name)
+}
+
+func TestAccSpotinstOceanAWSDataSource_Baseline(t *testing.T) {
+	clusterName := "test-acc-cluster-data-source"
+	controllerClusterID := "data-source-controller-id"
+	resourceName := createOceanAWSResourceName(clusterName)
+	dataSourceByIDName := createOceanAWSDataSourceName("by_id")
+	dataSourceByControllerIDName := createOceanAWSDataSourceName("by_controller_id")
+	dataSourceByNameName := createOceanAWSDataSourceName("by_name")
+
+	var cluster aws.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+				}) + fmt.Sprintf(testOceanAWSDataSourceConfig, resourceName, controllerClusterID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "controller_id", resourceName, "controller_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceByControllerIDName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByControllerIDName, "image_id", resourceName, "image_id"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "max_size", resourceName, "max_size"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "min_size", resourceName, "min_size"),
+				),
+			},
+		},
+	})
+}
+
+const testOceanAWSDataSourceConfig = `
+data "` + string(commons.OceanAWSResourceName) + `" "by_id" {
+	provider = "aws"
+	id       = %[1]v.id
+}
+
+data "` + string(commons.OceanAWSResourceName) + `" "by_controller_id" {
+	provider      = "aws"
+	controller_id = "%[2]v"
+	depends_on    = [%[1]v]
+}
+
+data "` + string(commons.OceanAWSResourceName) + `" "by_name" {
+	provider   = "aws"
+	name       = "%[3]v"
+	depends_on = [%[1]v]
+}
+`
@@ -45,6 +45,7 @@ const (
 	MultaiTargetSetID          commons.FieldName = "target_set_id"
 	MultaiBalancerID           commons.FieldName = "balancer_id"
 	Tags                       commons.FieldName = "tags"
+	TagsAll                    commons.FieldName = "tags_all"
 
 	RevertToSpot commons.FieldName = "revert_to_spot"
 	PerformAt    commons.FieldName = "perform_at"
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
@@ -233,6 +234,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, DesiredCapacity)
+		},
 	)
 
 	fieldsMap[CapacityUnit] = commons.NewGenericField(
@@ -785,6 +790,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				tags := elastigroup.Compute.LaunchSpecification.Tags
 				result = flattenTags(tags)
 			}
+			if err := commons.SetAllTags(resourceData, TagsAll, string(TagKey), string(TagValue), result); err != nil {
+				return err
+			}
+			result = commons.RemoveDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue), result)
 			if result != nil {
 				if err := resourceData.Set(string(Tags), result); err != nil {
 					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
@@ -795,7 +804,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -808,7 +817,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var tagsToAdd []*aws.Tag = nil
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -818,9 +827,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup.Compute.LaunchSpecification.SetTags(tagsToAdd)
 			return nil
 		},
-		nil,
+		commons.HasTagsChange(Tags, TagsAll),
 	)
 
+	fieldsMap[TagsAll] = commons.NewAllTagsField(commons.ElastigroupAWS, TagsAll, Tags, string(TagKey), string(TagValue))
+
 	fieldsMap[ElasticIps] = commons.NewGenericField(
 		commons.ElastigroupAWS,
 		ElasticIps,
@@ -1038,8 +1049,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(BatchSizePercentage): {
-									Type:     schema.TypeInt,
-									Required: true,
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 
 								string(GracePeriod): {
@@ -1080,9 +1092,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 											},
 
 											string(BatchMinHealthyPercentage): {
-												Type:     schema.TypeInt,
-												Optional: true,
-												Default:  50,
+												Type:         schema.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 100),
+												Default:      50,
 											},
 
 											string(OnFailure): {
@@ -1138,6 +1151,21 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		nil, nil, nil, nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			if !resourceDiff.NewValueKnown(string(WaitForCapacity)) || !resourceDiff.NewValueKnown(string(DesiredCapacity)) {
+				return nil
+			}
+			capacity, ok := resourceDiff.GetOk(string(WaitForCapacity))
+			if !ok {
+				return nil
+			}
+			if target := resourceDiff.Get(string(DesiredCapacity)).(int); capacity.(int) > target {
+				return fmt.Errorf("%s (%d) cannot be greater than %s (%d)",
+					WaitForCapacity, capacity.(int), DesiredCapacity, target)
+			}
+			return nil
+		},
 	)
 
 	fieldsMap[WaitForCapacityTimeout] = commons.NewGenericField(
@@ -41,8 +41,9 @@ func SetupNomad(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(AclToken): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
 					},
 
 					string(AutoscaleHeadroom): {
@@ -31,8 +31,9 @@ func SetupRancher(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(SecretKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(Version): {
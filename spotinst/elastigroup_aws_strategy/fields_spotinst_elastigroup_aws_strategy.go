@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
@@ -17,6 +18,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:          schema.TypeInt,
 			Optional:      true,
+			ValidateFunc:  validation.IntBetween(0, 100),
 			ConflictsWith: []string{string(OnDemandCount)},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
@@ -265,6 +266,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, DesiredCapacity)
+		},
 	)
 
 	fieldsMap[UpdatePolicy] = commons.NewGenericField(
@@ -288,8 +293,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(BatchSizePercentage): {
-									Type:     schema.TypeInt,
-									Required: true,
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 
 								string(GracePeriod): {
@@ -445,6 +445,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, TargetCapacity)
+		},
 	)
 
 	fieldsMap[UnhealthyDuration] = commons.NewGenericField(
@@ -174,7 +174,7 @@ func flattenCustom(customInstances []*gcp.CustomInstance) []interface{} {
 		result = append(result, m)
 	}
 
-	return []interface{}{result}
+	return result
 }
 
 func expandCustom(data interface{}) ([]*gcp.CustomInstance, error) {
@@ -14,9 +14,10 @@ const (
 )
 
 const (
-	Labels   commons.FieldName = "labels"
-	Metadata commons.FieldName = "metadata"
-	Tags     commons.FieldName = "tags"
+	Labels    commons.FieldName = "labels"
+	LabelsAll commons.FieldName = "labels_all"
+	Metadata  commons.FieldName = "metadata"
+	Tags      commons.FieldName = "tags"
 
 	BackendServices commons.FieldName = "backend_services"
 	Name            commons.FieldName = "name"
@@ -134,6 +134,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				labels := elastigroup.Compute.LaunchSpecification.Labels
 				result = flattenLabels(labels)
 			}
+			if err := commons.SetAllTags(resourceData, LabelsAll, string(LabelKey), string(LabelValue), result); err != nil {
+				return err
+			}
+			result = commons.RemoveDefaultTags(resourceData, meta, Labels, string(LabelKey), string(LabelValue), result)
 			if result != nil {
 				if err := resourceData.Set(string(Labels), result); err != nil {
 					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Labels), err)
@@ -144,7 +148,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
 			elastigroup := egWrapper.GetElastigroup()
-			if value, ok := resourceData.GetOk(string(Labels)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Labels, string(LabelKey), string(LabelValue)); ok {
 				if labels, err := expandLabels(value); err != nil {
 					return err
 				} else {
@@ -157,7 +161,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var labelList []*gcp.Label = nil
-			if value, ok := resourceData.GetOk(string(Labels)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Labels, string(LabelKey), string(LabelValue)); ok {
 				if labels, err := expandLabels(value); err != nil {
 					return err
 				} else {
@@ -167,9 +171,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup.Compute.LaunchSpecification.SetLabels(labelList)
 			return nil
 		},
-		nil,
+		commons.HasTagsChange(Labels, LabelsAll),
 	)
 
+	fieldsMap[LabelsAll] = commons.NewAllTagsField(commons.ElastigroupGCPLaunchConfiguration, LabelsAll, Labels, string(LabelKey), string(LabelValue))
+
 	fieldsMap[Metadata] = commons.NewGenericField(
 		commons.ElastigroupAWSLaunchConfiguration,
 		Metadata,
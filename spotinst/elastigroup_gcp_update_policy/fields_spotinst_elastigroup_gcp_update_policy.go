@@ -0,0 +1,65 @@
+package elastigroup_gcp_update_policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[UpdatePolicy] = commons.NewGenericField(
+		commons.ElastigroupGCPUpdatePolicy,
+		UpdatePolicy,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldRoll): {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+
+					string(RollConfig): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(BatchSizePercentage): {
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
+								},
+
+								string(GracePeriod): {
+									Type:     schema.TypeInt,
+									Optional: true,
+									Default:  -1,
+								},
+
+								string(HealthCheckType): {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+}
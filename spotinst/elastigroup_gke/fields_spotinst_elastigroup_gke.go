@@ -219,6 +219,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, TargetCapacity)
+		},
 	)
 
 	fieldsMap[PreemptiblePercentage] = commons.NewGenericField(
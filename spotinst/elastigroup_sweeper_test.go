@@ -7,18 +7,55 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
 )
 
+// sweepPrefixEnv overrides the prefixes of the names given to the resources
+// created by the acceptance tests, as a comma separated list.
+const sweepPrefixEnv = "SPOTINST_SWEEP_PREFIX"
+
+// defaultSweepPrefixes are the prefixes of the names given to the resources
+// created by the acceptance tests.
+var defaultSweepPrefixes = []string{"test-acc-", "terraform-acc-tests-"}
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
 
+// sweepPrefixes returns the prefixes of the names of the resources the
+// sweepers delete.
+func sweepPrefixes() []string {
+	v := os.Getenv(sweepPrefixEnv)
+	if v == "" {
+		return defaultSweepPrefixes
+	}
+
+	var prefixes []string
+	for _, prefix := range strings.Split(v, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// isSweepable reports whether name was given to a resource by the acceptance
+// tests.
+func isSweepable(name *string) bool {
+	for _, prefix := range sweepPrefixes() {
+		if strings.HasPrefix(spotinst.StringValue(name), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // getProviderClient returns a spotinst client setup with the correct cloud provider configs
 func getProviderClient(provider string) (interface{}, error) {
 	token := "SPOTINST_TOKEN_" + strings.ToUpper(provider)
 	account := "SPOTINST_ACCOUNT_" + strings.ToUpper(provider)
-	if os.Getenv(token) == "" && (os.Getenv(account) == "") {
-		return nil, fmt.Errorf("must provide environment variables SPOTINST_TOKEN_AWS and SPOTINST_ACCOUNT_AWS")
+	if os.Getenv(token) == "" || os.Getenv(account) == "" {
+		return nil, fmt.Errorf("must provide environment variables %s and %s", token, account)
 	}
 
 	conf := &Config{
@@ -34,3 +71,24 @@ func getProviderClient(provider string) (interface{}, error) {
 
 	return client, nil
 }
+
+func TestIsSweepable(t *testing.T) {
+	cases := []struct {
+		prefix string
+		name   string
+		want   bool
+	}{
+		{"", "test-acc-eg-baseline", true},
+		{"", "terraform-acc-tests-gke", true},
+		{"", "terraform-tests-do-not-delete", false},
+		{"", "eg-test-acc-baseline", false},
+		{"ci-", "test-acc-eg-baseline", false},
+		{"ci-, nightly-", "nightly-eg-baseline", true},
+	}
+	for _, c := range cases {
+		setenvForTest(t, sweepPrefixEnv, c.prefix)
+		if got := isSweepable(spotinst.String(c.name)); got != c.want {
+			t.Errorf("isSweepable(%q) with %s=%q = %v, want %v", c.name, sweepPrefixEnv, c.prefix, got, c.want)
+		}
+	}
+}
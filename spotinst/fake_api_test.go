@@ -0,0 +1,706 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+// fakeAPIEnvVar makes the acceptance tests run against an in-memory fake of
+// the Spotinst API instead of the real one, so they need no credentials and
+// create no cloud resources.
+const fakeAPIEnvVar = "SPOTINST_FAKE_API"
+
+// fakeCollection describes a collection of objects of the Spotinst API, such
+// as the Elastigroups at /aws/ec2/group.
+type fakeCollection struct {
+	// path is the path of the collection, and the path of its objects is
+	// path/{id}.
+	path string
+
+	// key is the key of the object in the request bodies, e.g. "group".
+	key string
+
+	// idPrefix is the prefix of the IDs of the objects.
+	idPrefix string
+
+	// notFoundCode is the error code returned for a missing object. When
+	// empty, a missing object is returned as an empty list of items.
+	notFoundCode string
+}
+
+var fakeCollections = []fakeCollection{
+	// Elastigroup.
+	{path: "/aws/ec2/group", key: "group", idPrefix: "sig", notFoundCode: ErrCodeGroupNotFound},
+	{path: "/gcp/gce/group", key: "group", idPrefix: "sig", notFoundCode: ErrCodeGroupNotFound},
+	{path: "/azure/compute/group", key: "group", idPrefix: "sig", notFoundCode: ErrCodeGroupNotFound},
+
+	// Ocean.
+	{path: "/ocean/aws/k8s/cluster", key: "cluster", idPrefix: "o", notFoundCode: ErrCodeClusterNotFound},
+	{path: "/ocean/aws/k8s/launchSpec", key: "launchSpec", idPrefix: "ols", notFoundCode: ErrCodeLaunchSpecNotFound},
+	{path: "/ocean/aws/ecs/cluster", key: "cluster", idPrefix: "o", notFoundCode: ErrCodeECSClusterNotFound},
+	{path: "/ocean/aws/ecs/launchSpec", key: "launchSpec", idPrefix: "ols", notFoundCode: ErrCodeECSLaunchSpecNotFound},
+	{path: "/ocean/gcp/k8s/cluster", key: "cluster", idPrefix: "o", notFoundCode: ErrCodeClusterNotFound},
+	{path: "/ocean/azure/k8s/cluster", key: "cluster", idPrefix: "o", notFoundCode: ErrCodeClusterNotFound},
+	{path: "/ocean/gcp/k8s/launchSpec", key: "launchSpec", idPrefix: "ols", notFoundCode: ErrCodeGKELaunchSpecNotFound},
+	{path: "/ocean/k8s/extendedResourceDefinition", key: "extendedResourceDefinition", idPrefix: "erd", notFoundCode: ErrCodeExtendedResourceDefinitionNotFound},
+
+	// Multai.
+	{path: "/loadBalancer/balancer", key: "balancer", idPrefix: "lb"},
+	{path: "/loadBalancer/listener", key: "listener", idPrefix: "ls"},
+	{path: "/loadBalancer/routingRule", key: "routingRule", idPrefix: "rr"},
+	{path: "/loadBalancer/middleware", key: "middleware", idPrefix: "mw"},
+	{path: "/loadBalancer/targetSet", key: "targetSet", idPrefix: "ts"},
+	{path: "/loadBalancer/target", key: "target", idPrefix: "t"},
+	{path: "/loadBalancer/deployment", key: "deployment", idPrefix: "dp"},
+	{path: "/loadBalancer/certificate", key: "certificate", idPrefix: "ce"},
+
+	// Stateful.
+	{path: "/azure/compute/statefulNode", key: "statefulNode", idPrefix: "ssn", notFoundCode: ErrCodeGroupNotFound},
+
+	// Notifications.
+	{path: "/events/subscription", key: "subscription", idPrefix: "sis"},
+}
+
+// fakeAPI is an httptest server implementing the create, read, update, delete
+// and list endpoints of the collections above. Objects are stored in memory,
+// updates are merged into the stored objects the way the Spotinst API does,
+// and the actions of an object (roll, status, ...) succeed right away.
+type fakeAPI struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]map[string]map[string]interface{}
+	actions []fakeRequest
+	lastID  int
+}
+
+// fakeRequest is an action request received by the fake API, so tests can
+// check the method and body the provider sent.
+type fakeRequest struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+func (r fakeRequest) String() string {
+	return fmt.Sprintf("%s %s", r.Method, r.Path)
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	api := &fakeAPI{
+		objects: make(map[string]map[string]map[string]interface{}),
+	}
+	api.Server = httptest.NewServer(api)
+	t.Cleanup(api.Close)
+	return api
+}
+
+// Client returns a client of the fake API.
+func (api *fakeAPI) Client() *Client {
+	config := Config{
+		Token:   "fake",
+		Account: "act-fake",
+
+		Endpoint: api.URL,
+	}
+
+	spotinstClient, diags := config.Client()
+	if diags.HasError() {
+		panic(fmt.Sprintf("failed to create the fake API client: %v", diags))
+	}
+	return spotinstClient
+}
+
+// Actions returns the action requests received so far, such as the rolls of a
+// cluster.
+func (api *fakeAPI) Actions() []fakeRequest {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]fakeRequest(nil), api.actions...)
+}
+
+// Len returns the number of objects stored at the given collection path.
+func (api *fakeAPI) Len(path string) int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return len(api.objects[path])
+}
+
+func (api *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	collection, id, action := matchFakeCollection(r.URL.Path)
+	if collection == nil {
+		writeFakeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("no such endpoint: %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	objects := api.objects[collection.path]
+	if objects == nil {
+		objects = make(map[string]map[string]interface{})
+		api.objects[collection.path] = objects
+	}
+
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			writeFakeItems(w, filterFakeObjects(objects, r))
+		case http.MethodPost:
+			object, err := decodeFakeObject(r, collection.key)
+			if err != nil {
+				writeFakeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+				return
+			}
+			api.lastID++
+			object["id"] = fmt.Sprintf("%s-%08x", collection.idPrefix, api.lastID)
+			objects[object["id"].(string)] = object
+			writeFakeItems(w, []interface{}{object})
+		default:
+			writeFakeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", r.Method)
+		}
+		return
+	}
+
+	// Imports return the object to create from an existing cloud resource,
+	// such as a GKE cluster, as it was sent.
+	if id == "import" || action == "import" || strings.HasPrefix(action, "import/") {
+		object, _ := decodeFakeObject(r, collection.key)
+		if object == nil {
+			object = make(map[string]interface{})
+		}
+		writeFakeItems(w, []interface{}{object})
+		return
+	}
+
+	object, ok := objects[id]
+	if !ok {
+		if collection.notFoundCode == "" {
+			writeFakeItems(w, nil)
+			return
+		}
+		writeFakeError(w, http.StatusBadRequest, collection.notFoundCode,
+			fmt.Sprintf("%s %s does not exist", collection.key, id))
+		return
+	}
+
+	if action != "" {
+		request := fakeRequest{Method: r.Method, Path: r.URL.Path}
+		if err := json.NewDecoder(r.Body).Decode(&request.Body); err != nil && err != io.EOF {
+			writeFakeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid request body: %v", err))
+			return
+		}
+		api.actions = append(api.actions, request)
+		writeFakeItems(w, fakeAction(collection, action))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeItems(w, []interface{}{object})
+	case http.MethodPut:
+		update, err := decodeFakeObject(r, collection.key)
+		if err != nil {
+			writeFakeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+		delete(update, "id")
+		mergeFakeObject(object, update)
+		writeFakeItems(w, []interface{}{object})
+	case http.MethodDelete:
+		delete(objects, id)
+		writeFakeItems(w, nil)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", r.Method)
+	}
+}
+
+// matchFakeCollection returns the collection of a request path, along with the
+// ID of the object and the action on it, if any.
+func matchFakeCollection(path string) (*fakeCollection, string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := range fakeCollections {
+		collection := &fakeCollections[i]
+		prefix := strings.Split(strings.Trim(collection.path, "/"), "/")
+		if len(segments) < len(prefix) || strings.Join(segments[:len(prefix)], "/") != strings.Join(prefix, "/") {
+			continue
+		}
+
+		rest := segments[len(prefix):]
+		switch len(rest) {
+		case 0:
+			return collection, "", ""
+		case 1:
+			return collection, rest[0], ""
+		default:
+			return collection, rest[0], strings.Join(rest[1:], "/")
+		}
+	}
+	return nil, "", ""
+}
+
+// fakeAction returns the response items of an action on an object. Rolls are
+// started and completed right away, stateful nodes are active, and the other
+// actions return no items.
+func fakeAction(collection *fakeCollection, action string) []interface{} {
+	switch {
+	case action == "roll", action == "clusterRoll":
+		return []interface{}{map[string]interface{}{"id": "sbgd-00000001", "status": "IN_PROGRESS"}}
+	case strings.HasPrefix(action, "roll/"), strings.HasPrefix(action, "clusterRoll/"):
+		return []interface{}{map[string]interface{}{
+			"id":       strings.SplitN(action, "/", 2)[1],
+			"status":   "COMPLETED",
+			"progress": map[string]interface{}{"unit": "percent", "value": 100},
+		}}
+	case action == "status" && collection.key == "statefulNode":
+		return []interface{}{map[string]interface{}{"status": "ACTIVE"}}
+	default:
+		return nil
+	}
+}
+
+func decodeFakeObject(r *http.Request, key string) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %v", err)
+	}
+	object, ok := body[key].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("missing %q in request body", key)
+	}
+	return object, nil
+}
+
+// filterFakeObjects returns the objects matching the query parameters of a
+// list request, such as the launch specs of a cluster (?oceanId=o-123).
+func filterFakeObjects(objects map[string]map[string]interface{}, r *http.Request) []interface{} {
+	ids := make([]string, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		object := objects[id]
+		matches := true
+		for key, values := range r.URL.Query() {
+			if key == "accountId" {
+				continue
+			}
+			if value, ok := object[key].(string); ok && value != values[0] {
+				matches = false
+			}
+		}
+		if matches {
+			items = append(items, object)
+		}
+	}
+	return items
+}
+
+// mergeFakeObject merges an update into an object: nested objects are merged,
+// and null values remove the existing ones.
+func mergeFakeObject(object map[string]interface{}, update map[string]interface{}) {
+	for key, value := range update {
+		if value == nil {
+			delete(object, key)
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			if existing, ok := object[key].(map[string]interface{}); ok {
+				mergeFakeObject(existing, nested)
+				continue
+			}
+		}
+		object[key] = value
+	}
+}
+
+func writeFakeItems(w http.ResponseWriter, items []interface{}) {
+	if items == nil {
+		items = []interface{}{}
+	}
+	writeFakeResponse(w, http.StatusOK, map[string]interface{}{
+		"status": map[string]interface{}{"code": http.StatusOK, "message": "OK"},
+		"items":  items,
+		"count":  len(items),
+	})
+}
+
+func writeFakeError(w http.ResponseWriter, status int, code string, message string) {
+	writeFakeResponse(w, status, map[string]interface{}{
+		"status": map[string]interface{}{"code": status, "message": http.StatusText(status)},
+		"errors": []interface{}{map[string]interface{}{"code": code, "message": message}},
+	})
+}
+
+func writeFakeResponse(w http.ResponseWriter, status int, response map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"request":  map[string]interface{}{"id": "fake-request"},
+		"response": response,
+	})
+}
+
+// useFakeAPI points the acceptance test providers to a new fake API for the
+// duration of the test.
+func useFakeAPI(t *testing.T) *fakeAPI {
+	api := newFakeAPI(t)
+	configure := func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return api.Client(), nil
+	}
+
+	for _, p := range TestAccProviders {
+		p, previous := p, p.ConfigureContextFunc
+		p.ConfigureContextFunc = configure
+		t.Cleanup(func() { p.ConfigureContextFunc = previous })
+	}
+	return api
+}
+
+// testFakeAPIUnitTest runs an acceptance test against the fake API as part of
+// the unit tests. The test harness drives the Terraform CLI, so the test is
+// skipped when it cannot be found.
+func testFakeAPIUnitTest(t *testing.T, acceptanceTest func(t *testing.T)) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run the test")
+		}
+	}
+
+	setenvForTest(t, resource.TestEnvVar, "1")
+	setenvForTest(t, fakeAPIEnvVar, "1")
+	acceptanceTest(t)
+}
+
+func setenvForTest(t *testing.T, key string, value string) {
+	previous, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestFakeAPI_NotFound(t *testing.T) {
+	api := newFakeAPI(t)
+	spotinstClient := api.Client()
+
+	_, err := readOceanAWSClusterByID(context.Background(), "o-missing", spotinstClient)
+	if err == nil || !strings.Contains(err.Error(), `no Ocean cluster found with ID "o-missing"`) {
+		t.Fatalf("expected a missing cluster error, got %v", err)
+	}
+
+	r := client.NewRequest(http.MethodGet, "/aws/ec2/group/sig-missing")
+	err = doAPIRequest(context.Background(), r, spotinstClient)
+	if errs, ok := err.(client.Errors); !ok || len(errs) == 0 || errs[0].Code != ErrCodeGroupNotFound {
+		t.Fatalf("expected a %s error, got %v", ErrCodeGroupNotFound, err)
+	}
+}
+
+func TestFakeAPI_Lifecycle(t *testing.T) {
+	cases := []struct {
+		resourceName string
+		path         string
+		config       map[string]interface{}
+		update       map[string]interface{}
+	}{
+		{
+			resourceName: "spotinst_elastigroup_aws",
+			path:         "/aws/ec2/group",
+			config: map[string]interface{}{
+				"name":                    "fake-group",
+				"product":                 "Linux/UNIX",
+				"availability_zones":      []interface{}{"us-west-2a"},
+				"security_groups":         []interface{}{"sg-123456"},
+				"instance_types_ondemand": "m5.large",
+				"instance_types_spot":     []interface{}{"m5.large"},
+				"desired_capacity":        1,
+				"min_size":                0,
+				"max_size":                2,
+			},
+			update: map[string]interface{}{"max_size": 3},
+		},
+		{
+			resourceName: "spotinst_ocean_aws",
+			path:         "/ocean/aws/k8s/cluster",
+			config: map[string]interface{}{
+				"name":            "fake-cluster",
+				"controller_id":   "fake-controller",
+				"region":          "us-west-2",
+				"subnet_ids":      []interface{}{"subnet-123456"},
+				"security_groups": []interface{}{"sg-123456"},
+				"image_id":        "ami-123456",
+			},
+			update: map[string]interface{}{"max_size": 10},
+		},
+		{
+			resourceName: "spotinst_multai_balancer",
+			path:         "/loadBalancer/balancer",
+			config: map[string]interface{}{
+				"name": "fake-balancer",
+			},
+		},
+	}
+
+	provider := Provider()
+	for _, c := range cases {
+		t.Run(c.resourceName, func(t *testing.T) {
+			api := newFakeAPI(t)
+			meta := api.Client()
+			r := provider.ResourcesMap[c.resourceName]
+			ctx := context.Background()
+
+			resourceData := schema.TestResourceDataRaw(t, r.Schema, c.config)
+			if diags := r.CreateContext(ctx, resourceData, meta); diags.HasError() {
+				t.Fatalf("create failed: %v", diags)
+			}
+			if resourceData.Id() == "" || api.Len(c.path) != 1 {
+				t.Fatalf("expected the object to be created, got ID %q and %d objects", resourceData.Id(), api.Len(c.path))
+			}
+			for key, value := range c.config {
+				if _, ok := value.([]interface{}); ok {
+					continue
+				}
+				if got := resourceData.Get(key); got != value {
+					t.Fatalf("expected %s to be read back as %v, got %v", key, value, got)
+				}
+			}
+
+			if c.update != nil {
+				config := make(map[string]interface{})
+				for key, value := range c.config {
+					config[key] = value
+				}
+				for key, value := range c.update {
+					config[key] = value
+				}
+
+				// Plan the update against the created state, so only the
+				// updated fields are seen as changed.
+				state := resourceData.State()
+				diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+				if err != nil {
+					t.Fatalf("plan failed: %v", err)
+				}
+				newState, diags := r.Apply(ctx, state, diff, meta)
+				if diags.HasError() {
+					t.Fatalf("update failed: %v", diags)
+				}
+				updated := r.Data(newState)
+				for key, value := range c.update {
+					if got := updated.Get(key); got != value {
+						t.Fatalf("expected %s to be updated to %v, got %v", key, value, got)
+					}
+				}
+			}
+
+			if diags := r.DeleteContext(ctx, resourceData, meta); diags.HasError() {
+				t.Fatalf("delete failed: %v", diags)
+			}
+			if api.Len(c.path) != 0 {
+				t.Fatalf("expected the object to be deleted")
+			}
+
+			// A read of the deleted object removes it from the state.
+			resourceData.SetId(fmt.Sprintf("%s-missing", c.resourceName))
+			if diags := r.ReadContext(ctx, resourceData, meta); diags.HasError() {
+				t.Fatalf("read of a deleted object failed: %v", diags)
+			}
+			if resourceData.Id() != "" {
+				t.Fatalf("expected the deleted object to be removed from the state")
+			}
+		})
+	}
+}
+
+func TestFakeAPI_LaunchSpecConditionedRoll(t *testing.T) {
+	cases := []struct {
+		resourceName string
+		clusterPath  string
+		config       map[string]interface{}
+		imageField   string
+	}{
+		{
+			resourceName: "spotinst_ocean_aws_launch_spec",
+			clusterPath:  "/ocean/aws/k8s/cluster",
+			config:       map[string]interface{}{"image_id": "ami-123456"},
+			imageField:   "image_id",
+		},
+		{
+			resourceName: "spotinst_ocean_ecs_launch_spec",
+			clusterPath:  "/ocean/aws/ecs/cluster",
+			config:       map[string]interface{}{"name": "fake-launch-spec", "image_id": "ami-123456"},
+			imageField:   "image_id",
+		},
+		{
+			resourceName: "spotinst_ocean_gke_launch_spec",
+			clusterPath:  "/ocean/gcp/k8s/cluster",
+			config:       map[string]interface{}{"source_image": "https://www.googleapis.com/compute/v1/projects/fake/global/images/fake-1"},
+			imageField:   "source_image",
+		},
+	}
+
+	provider := Provider()
+	for _, c := range cases {
+		t.Run(c.resourceName, func(t *testing.T) {
+			api := newFakeAPI(t)
+			meta := api.Client()
+			r := provider.ResourcesMap[c.resourceName]
+			ctx := context.Background()
+
+			api.objects[c.clusterPath] = map[string]map[string]interface{}{
+				"o-12345678": {"id": "o-12345678"},
+			}
+
+			config := map[string]interface{}{
+				"ocean_id": "o-12345678",
+				"update_policy": []interface{}{map[string]interface{}{
+					"should_roll":      true,
+					"conditioned_roll": true,
+					"roll_config":      []interface{}{map[string]interface{}{"batch_size_percentage": 20}},
+				}},
+			}
+			for key, value := range c.config {
+				config[key] = value
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, r.Schema, config)
+			if diags := r.CreateContext(ctx, resourceData, meta); diags.HasError() {
+				t.Fatalf("create failed: %v", diags)
+			}
+			state := resourceData.State()
+
+			apply := func(change map[string]interface{}, rolls int) {
+				t.Helper()
+				for key, value := range change {
+					config[key] = value
+				}
+				diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+				if err != nil {
+					t.Fatalf("plan failed: %v", err)
+				}
+				newState, diags := r.Apply(ctx, state, diff, meta)
+				if diags.HasError() {
+					t.Fatalf("update failed: %v", diags)
+				}
+				state = newState
+				if actions := api.Actions(); len(actions) != rolls {
+					t.Fatalf("expected %d rolls after updating %v, got %v", rolls, change, actions)
+				}
+			}
+
+			// The headrooms apply to the running instances, the image only to
+			// new ones.
+			apply(map[string]interface{}{
+				"autoscale_headrooms": []interface{}{map[string]interface{}{"num_of_units": 1, "cpu_per_unit": 1024}},
+			}, 0)
+			apply(map[string]interface{}{c.imageField: c.config[c.imageField].(string) + "-updated"}, 1)
+
+			// Without conditioned_roll, every update rolls the launch spec.
+			config["update_policy"].([]interface{})[0].(map[string]interface{})["conditioned_roll"] = false
+			apply(map[string]interface{}{
+				"autoscale_headrooms": []interface{}{map[string]interface{}{"num_of_units": 2, "cpu_per_unit": 1024}},
+			}, 2)
+		})
+	}
+}
+
+func TestFakeAPI_DefaultTagsChange(t *testing.T) {
+	api := newFakeAPI(t)
+	r := Provider().ResourcesMap["spotinst_ocean_aws"]
+	ctx := context.Background()
+
+	config := map[string]interface{}{
+		"name":            "fake-cluster",
+		"controller_id":   "fake-controller",
+		"region":          "us-west-2",
+		"subnet_ids":      []interface{}{"subnet-123456"},
+		"security_groups": []interface{}{"sg-123456"},
+		"image_id":        "ami-123456",
+		"tags":            []interface{}{map[string]interface{}{"key": "name", "value": "web"}},
+	}
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(config), api.Client())
+	if err != nil {
+		t.Fatalf("plan failed: %v", err)
+	}
+	state, diags := r.Apply(ctx, nil, diff, api.Client())
+	if diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	// A default tag added to the provider is planned and applied to the
+	// existing cluster, without showing up in its own tags.
+	meta := api.Client()
+	meta.defaultTags = map[string]string{"owner": "platform"}
+	diff, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("plan failed: %v", err)
+	}
+	if diff == nil || diff.Attributes["tags_all.owner"] == nil {
+		t.Fatalf("expected the new default tag to be planned, got %v", diff)
+	}
+	newState, diags := r.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+
+	cluster := api.objects["/ocean/aws/k8s/cluster"][state.ID]
+	tags := cluster["compute"].(map[string]interface{})["launchSpecification"].(map[string]interface{})["tags"]
+	if got := fmt.Sprint(tags); !strings.Contains(got, "owner") || !strings.Contains(got, "platform") {
+		t.Fatalf("expected the default tag to be sent to the API, got %v", got)
+	}
+	updated := r.Data(newState)
+	if got := updated.Get("tags").(*schema.Set).Len(); got != 1 {
+		t.Fatalf("expected the default tag to be left out of the tags, got %d tags", got)
+	}
+
+	diff, err = r.Diff(ctx, newState, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("plan failed: %v", err)
+	}
+	// The flatmap state of the test drops empty lists, such as the roll
+	// attributes of the cluster, so only the tags are checked.
+	if diff != nil {
+		for name, attribute := range diff.Attributes {
+			if strings.HasPrefix(name, "tags") {
+				t.Fatalf("expected no change of the tags once the default tag is applied, got %s: %#v", name, attribute)
+			}
+		}
+	}
+}
+
+func TestUnitSpotinstElastigroupAWS_Baseline(t *testing.T) {
+	testFakeAPIUnitTest(t, TestAccSpotinstElastigroupAWS_Baseline)
+}
+
+func TestUnitSpotinstOceanAWS_Baseline(t *testing.T) {
+	testFakeAPIUnitTest(t, TestAccSpotinstOceanAWS_Baseline)
+}
+
+func TestUnitSpotinstMultaiBalancer_Baseline(t *testing.T) {
+	testFakeAPIUnitTest(t, TestAccSpotinstMultaiBalancer_Baseline)
+}
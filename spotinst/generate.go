@@ -0,0 +1,527 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+// generateSource lists the existing resources of a type, for the
+// configuration generator.
+type generateSource struct {
+	resourceName commons.ResourceName
+	list         func(ctx context.Context, meta interface{}) ([]namedResource, error)
+}
+
+// generateSources holds the resource types the configuration generator
+// supports, in the order of the generated configuration.
+var generateSources = []generateSource{
+	// Elastigroup.
+	{resourceName: commons.ElastigroupAWSResourceName, list: listElastigroupAWSNames},
+	{resourceName: commons.ElastigroupAzureV3ResourceName, list: listElastigroupAzureV3Names},
+	{resourceName: commons.ElastigroupGCPResourceName, list: listElastigroupGCPNames},
+
+	// Ocean.
+	{resourceName: commons.OceanAWSResourceName, list: listOceanAWSClusterNames},
+	{resourceName: commons.OceanAWSLaunchSpecResourceName, list: listOceanAWSLaunchSpecNames},
+	{resourceName: commons.OceanECSResourceName, list: listOceanECSClusterNames},
+	{resourceName: commons.OceanECSLaunchSpecResourceName, list: listOceanECSLaunchSpecNames},
+	{resourceName: commons.OceanGKEImportResourceName, list: listOceanGKEClusterNames},
+	{resourceName: commons.OceanGKELaunchSpecResourceName, list: listOceanGKELaunchSpecNames},
+	{resourceName: commons.OceanAKSResourceName, list: listOceanAKSClusterNames},
+
+	// Multai.
+	{resourceName: commons.MultaiDeploymentResourceName, list: listMultaiDeploymentNames},
+	{resourceName: commons.MultaiBalancerResourceName, list: listMultaiBalancerNames},
+	{resourceName: commons.MultaiTargetSetResourceName, list: listMultaiTargetSetNames},
+	{resourceName: commons.MultaiTargetResourceName, list: listMultaiTargetNames},
+	{resourceName: commons.MultaiListenerResourceName, list: listMultaiListenerNames},
+	{resourceName: commons.MultaiRoutingRuleResourceName, list: listMultaiRoutingRuleNames},
+}
+
+// generatedResource is the configuration of an existing resource.
+type generatedResource struct {
+	resourceName string
+	label        string
+	id           string
+
+	// state is the state of the resource, as imported by Terraform.
+	state *terraform.InstanceState
+
+	// config holds the arguments of the resource, in the form of the raw
+	// configuration of a Terraform resource.
+	config map[string]interface{}
+}
+
+// Generate writes the Terraform configuration of the existing resources of the
+// account, along with the `import` blocks importing them. The provider is
+// configured from the environment, and resourceNames restricts the generated
+// resource types when not empty.
+func Generate(ctx context.Context, w io.Writer, resourceNames []string) error {
+	provider := Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return fmt.Errorf("failed to configure the provider: %v", diags)
+	}
+
+	resources, err := generateResources(ctx, provider, provider.Meta(), resourceNames)
+	if werr := writeGeneratedConfig(w, resources); werr != nil {
+		return werr
+	}
+	return err
+}
+
+// generateResources imports and reads the existing resources. A resource that
+// fails to be read is left out of the result, and reported in the error.
+func generateResources(ctx context.Context, provider *schema.Provider, meta interface{}, resourceNames []string) ([]*generatedResource, error) {
+	sources, err := filterGenerateSources(resourceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []*generatedResource
+	var errs []string
+	for _, source := range sources {
+		resourceName := string(source.resourceName)
+		r := provider.ResourcesMap[resourceName]
+
+		existing, err := source.list(ctx, meta)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", resourceName, err))
+			continue
+		}
+
+		labels := make(map[string]bool)
+		for _, resource := range existing {
+			state, err := importResourceState(ctx, r, resource.id, meta)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s %q: %v", resourceName, resource.id, err))
+				continue
+			}
+			if state == nil || state.ID == "" {
+				// The resource was deleted since it was listed.
+				continue
+			}
+
+			resources = append(resources, &generatedResource{
+				resourceName: resourceName,
+				label:        generateLabel(resource, labels),
+				id:           state.ID,
+				state:        state,
+				config:       generateConfig(r.Schema, r.Data(state)),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return resources, fmt.Errorf("failed to generate the configuration of:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return resources, nil
+}
+
+func filterGenerateSources(resourceNames []string) ([]generateSource, error) {
+	if len(resourceNames) == 0 {
+		return generateSources, nil
+	}
+
+	var sources []generateSource
+	for _, resourceName := range resourceNames {
+		found := false
+		for _, source := range generateSources {
+			if string(source.resourceName) == resourceName {
+				sources = append(sources, source)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("cannot generate the configuration of %q", resourceName)
+		}
+	}
+	return sources, nil
+}
+
+// importResourceState imports a resource and refreshes it, as `terraform
+// import` does. The state is nil when the resource does not exist.
+func importResourceState(ctx context.Context, r *schema.Resource, importID string, meta interface{}) (*terraform.InstanceState, error) {
+	resourceData := r.Data(nil)
+	resourceData.SetId(importID)
+
+	imported, err := r.Importer.StateContext(ctx, resourceData, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, imported[0].State(), meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%v", diags)
+	}
+	return state, nil
+}
+
+// generateConfig returns the arguments of a resource. Computed-only attributes
+// are left out, as are the ones that are unset or set to their default.
+func generateConfig(schemaMap map[string]*schema.Schema, resourceData *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+	for key, s := range schemaMap {
+		if value, ok := generateValue(s, resourceData.Get(key)); ok {
+			config[key] = value
+		}
+	}
+	return config
+}
+
+func generateValue(s *schema.Schema, value interface{}) (interface{}, bool) {
+	if !s.Required && !s.Optional {
+		return nil, false
+	}
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		list, _ := value.([]interface{})
+
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			return list, len(list) > 0 || s.Required
+		}
+
+		blocks := make([]interface{}, 0, len(list))
+		for _, item := range list {
+			block := make(map[string]interface{})
+			if m, ok := item.(map[string]interface{}); ok {
+				for key, elemSchema := range elem.Schema {
+					if elemValue, ok := generateValue(elemSchema, m[key]); ok {
+						block[key] = elemValue
+					}
+				}
+			}
+			blocks = append(blocks, block)
+		}
+		return blocks, len(blocks) > 0 || s.Required
+
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		return m, len(m) > 0 || s.Required
+
+	default:
+		if s.Required {
+			return value, true
+		}
+		if s.Default != nil {
+			return value, value != s.Default
+		}
+		return value, value != nil && value != "" && value != 0 && value != 0.0 && value != false
+	}
+}
+
+var labelInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// generateLabel returns a unique resource label, made from the name of the
+// resource or from its ID for nameless resources.
+func generateLabel(resource namedResource, labels map[string]bool) string {
+	label := resource.name
+	if label == "" {
+		label = resource.id
+	}
+	label = strings.Trim(labelInvalidChars.ReplaceAllString(strings.ToLower(label), "_"), "_-")
+	if label == "" || !(label[0] >= 'a' && label[0] <= 'z') {
+		label = "r_" + label
+	}
+
+	unique := label
+	for i := 2; labels[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[unique] = true
+	return unique
+}
+
+// writeGeneratedConfig writes the `import` block and the `resource` block of
+// each generated resource. The top-level `*_id` arguments referring to another
+// generated resource are written as references to it.
+func writeGeneratedConfig(w io.Writer, resources []*generatedResource) error {
+	references := make(map[string]string)
+	for _, resource := range resources {
+		references[resource.id] = fmt.Sprintf("%s.%s.id", resource.resourceName, resource.label)
+	}
+
+	var b strings.Builder
+	for i, resource := range resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resource.resourceName, resource.label, hclString(resource.id))
+		fmt.Fprintf(&b, "resource %s %s {\n", hclString(resource.resourceName), hclString(resource.label))
+		writeHCLBody(&b, resource.config, "  ", func(key string, value interface{}) (string, bool) {
+			id, ok := value.(string)
+			if !ok || !strings.HasSuffix(key, "_id") || id == resource.id {
+				return "", false
+			}
+			reference, ok := references[id]
+			return reference, ok
+		})
+		b.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeHCLBody writes the attributes of a block, aligned as `terraform fmt`
+// does, followed by its nested blocks. reference returns the expression to
+// write instead of the value of an attribute, if any.
+func writeHCLBody(b *strings.Builder, body map[string]interface{}, indent string, reference func(key string, value interface{}) (string, bool)) {
+	keys := make([]string, 0, len(body))
+	for key := range body {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	width := 0
+	for _, key := range keys {
+		if !isHCLBlock(body[key]) && len(key) > width {
+			width = len(key)
+		}
+	}
+
+	for _, key := range keys {
+		if isHCLBlock(body[key]) {
+			continue
+		}
+		expr, ok := "", false
+		if reference != nil {
+			expr, ok = reference(key, body[key])
+		}
+		if !ok {
+			expr = hclValue(body[key])
+		}
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, key, expr)
+	}
+
+	for _, key := range keys {
+		if !isHCLBlock(body[key]) {
+			continue
+		}
+		for _, block := range body[key].([]interface{}) {
+			fmt.Fprintf(b, "\n%s%s {\n", indent, key)
+			writeHCLBody(b, block.(map[string]interface{}), indent+"  ", nil)
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
+
+func isHCLBlock(value interface{}) bool {
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return false
+	}
+	_, ok = list[0].(map[string]interface{})
+	return ok
+}
+
+func hclValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return hclString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, hclValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]string, 0, len(keys))
+		for _, key := range keys {
+			items = append(items, hclString(key)+" = "+hclValue(v[key]))
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	default:
+		return hclString(fmt.Sprint(v))
+	}
+}
+
+// hclString quotes a string, escaping the sequences HCL would interpolate.
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func listOceanAWSLaunchSpecNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListLaunchSpecs(ctx, &aws.ListLaunchSpecsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launchSpecs: %s", err)
+	}
+
+	launchSpecs := make([]namedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, namedResource{id: spotinst.StringValue(launchSpec.ID), name: spotinst.StringValue(launchSpec.Name)})
+	}
+	return launchSpecs, nil
+}
+
+func listOceanECSLaunchSpecNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListECSLaunchSpecs(ctx, &aws.ListECSLaunchSpecsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launchSpecs: %s", err)
+	}
+
+	launchSpecs := make([]namedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, namedResource{id: spotinst.StringValue(launchSpec.ID), name: spotinst.StringValue(launchSpec.Name)})
+	}
+	return launchSpecs, nil
+}
+
+func listOceanGKEClusterNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ListClusters(ctx, &gcp.ListClustersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %s", err)
+	}
+
+	clusters := make([]namedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, namedResource{id: spotinst.StringValue(cluster.ID), name: spotinst.StringValue(cluster.Name)})
+	}
+	return clusters, nil
+}
+
+func listOceanGKELaunchSpecNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ListLaunchSpecs(ctx, &gcp.ListLaunchSpecsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launchSpecs: %s", err)
+	}
+
+	launchSpecs := make([]namedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, namedResource{id: spotinst.StringValue(launchSpec.ID), name: spotinst.StringValue(launchSpec.Name)})
+	}
+	return launchSpecs, nil
+}
+
+func listMultaiDeploymentNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).multai.ListDeployments(ctx, &multai.ListDeploymentsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %s", err)
+	}
+
+	deployments := make([]namedResource, 0, len(resp.Deployments))
+	for _, deployment := range resp.Deployments {
+		deployments = append(deployments, namedResource{id: spotinst.StringValue(deployment.ID), name: spotinst.StringValue(deployment.Name)})
+	}
+	return deployments, nil
+}
+
+func listMultaiBalancerNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).multai.ListLoadBalancers(ctx, &multai.ListLoadBalancersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list balancers: %s", err)
+	}
+
+	balancers := make([]namedResource, 0, len(resp.Balancers))
+	for _, balancer := range resp.Balancers {
+		balancers = append(balancers, namedResource{id: spotinst.StringValue(balancer.ID), name: spotinst.StringValue(balancer.Name)})
+	}
+	return balancers, nil
+}
+
+func listMultaiTargetSetNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).multai.ListTargetSets(ctx, &multai.ListTargetSetsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list target sets: %s", err)
+	}
+
+	targetSets := make([]namedResource, 0, len(resp.TargetSets))
+	for _, targetSet := range resp.TargetSets {
+		targetSets = append(targetSets, namedResource{id: spotinst.StringValue(targetSet.ID), name: spotinst.StringValue(targetSet.Name)})
+	}
+	return targetSets, nil
+}
+
+func listMultaiTargetNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).multai.ListTargets(ctx, &multai.ListTargetsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list targets: %s", err)
+	}
+
+	targets := make([]namedResource, 0, len(resp.Targets))
+	for _, target := range resp.Targets {
+		targets = append(targets, namedResource{id: spotinst.StringValue(target.ID), name: spotinst.StringValue(target.Name)})
+	}
+	return targets, nil
+}
+
+func listMultaiListenerNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).multai.ListListeners(ctx, &multai.ListListenersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list listeners: %s", err)
+	}
+
+	listeners := make([]namedResource, 0, len(resp.Listeners))
+	for _, listener := range resp.Listeners {
+		listeners = append(listeners, namedResource{id: spotinst.StringValue(listener.ID)})
+	}
+	return listeners, nil
+}
+
+func listMultaiRoutingRuleNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).multai.ListRoutingRules(ctx, &multai.ListRoutingRulesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routing rules: %s", err)
+	}
+
+	routingRules := make([]namedResource, 0, len(resp.RoutingRules))
+	for _, routingRule := range resp.RoutingRules {
+		routingRules = append(routingRules, namedResource{id: spotinst.StringValue(routingRule.ID)})
+	}
+	return routingRules, nil
+}
@@ -0,0 +1,219 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func TestGenerate_ZeroDiff(t *testing.T) {
+	// The fake API launches no instances, and creating a group or a cluster
+	// with an instance profile waits for the profile to be ready.
+	overrides := map[string]map[string]interface{}{
+		string(commons.ElastigroupAWSResourceName): {
+			"wait_for_capacity":         nil,
+			"wait_for_capacity_timeout": nil,
+			"iam_instance_profile":      nil,
+		},
+		string(commons.OceanAWSResourceName): {"iam_instance_profile": nil},
+		string(commons.OceanECSResourceName): {"iam_instance_profile": nil},
+	}
+
+	cases := roundTripCases()
+	provider := Provider()
+	for _, source := range generateSources {
+		resourceName := string(source.resourceName)
+		t.Run(resourceName, func(t *testing.T) {
+			api := newFakeAPI(t)
+			meta := api.Client()
+			r := provider.ResourcesMap[resourceName]
+			ctx := context.Background()
+
+			config := roundTripConfig(r.Schema, "", cases[resourceName].config)
+			for key, value := range overrides[resourceName] {
+				if value == nil {
+					delete(config, key)
+				} else {
+					config[key] = value
+				}
+			}
+			delete(config, string(commons.ResourceAccountID))
+			created := schema.TestResourceDataRaw(t, r.Schema, config)
+
+			var id string
+			if source.resourceName == commons.OceanAKSResourceName {
+				// AKS clusters are created on top of the imported AKS cluster,
+				// which the fake API cannot provide, so the cluster is stored
+				// as it would have been created.
+				id = "o-12345678"
+				imported := roundTripImportedObject(reflect.TypeOf(azure.Cluster{}), 0).Interface().(*azure.Cluster)
+				cluster, err := commons.OceanAKSResource.OnCreate(imported, created, meta)
+				if err != nil {
+					t.Fatalf("onCreate failed: %v", err)
+				}
+				cluster.SetId(spotinst.String(id))
+				api.objects["/ocean/azure/k8s/cluster"] = map[string]map[string]interface{}{
+					id: testFakeObject(t, cluster),
+				}
+			} else {
+				if diags := r.CreateContext(ctx, created, meta); diags.HasError() {
+					t.Fatalf("create failed: %v", diags)
+				}
+				id = created.Id()
+			}
+
+			resources, err := generateResources(ctx, provider, meta, []string{resourceName})
+			if err != nil {
+				t.Fatalf("generate failed: %v", err)
+			}
+			if len(resources) != 1 || resources[0].id != id {
+				t.Fatalf("expected %q to be generated, got %d resources", id, len(resources))
+			}
+
+			generated := terraform.NewResourceConfigRaw(resources[0].config)
+			if diags := r.Validate(generated); diags.HasError() {
+				t.Fatalf("the generated configuration is invalid: %v", diags)
+			}
+
+			diff, err := r.Diff(ctx, resources[0].state, generated, meta)
+			if err != nil {
+				t.Fatalf("plan of the generated configuration failed: %v", err)
+			}
+			if !diff.Empty() {
+				t.Fatalf("expected no changes with the generated configuration, got:\n%v", diff)
+			}
+
+			var b strings.Builder
+			if err := writeGeneratedConfig(&b, resources); err != nil {
+				t.Fatalf("failed to write the generated configuration: %v", err)
+			}
+			if !strings.Contains(b.String(), "  id = \""+id+"\"\n") {
+				t.Fatalf("expected an import block for %q, got:\n%s", id, b.String())
+			}
+		})
+	}
+}
+
+// testFakeObject returns an object of the fake API, as it is stored from the
+// request bodies.
+func testFakeObject(t *testing.T, object interface{}) map[string]interface{} {
+	body, err := json.Marshal(object)
+	if err != nil {
+		t.Fatalf("failed to serialize the object: %v", err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(body, &m); err != nil {
+		t.Fatalf("failed to deserialize the object: %v", err)
+	}
+	return m
+}
+
+func TestGenerate_UnsupportedResource(t *testing.T) {
+	api := newFakeAPI(t)
+	_, err := generateResources(context.Background(), Provider(), api.Client(), []string{"spotinst_foo"})
+	if err == nil || !strings.Contains(err.Error(), `"spotinst_foo"`) {
+		t.Fatalf("expected the generation of an unsupported resource to fail, got %v", err)
+	}
+}
+
+func TestWriteGeneratedConfig(t *testing.T) {
+	resources := []*generatedResource{
+		{
+			resourceName: "spotinst_multai_balancer",
+			label:        "web",
+			id:           "lb-12345678",
+			config: map[string]interface{}{
+				"name":                "web",
+				"dns_cname_aliases":   []interface{}{"a.example.com", "b.example.com"},
+				"connection_timeouts": []interface{}{map[string]interface{}{"idle": 10, "draining": 20}},
+				"tags": []interface{}{
+					map[string]interface{}{"key": "env", "value": "prod"},
+					map[string]interface{}{"key": "script", "value": "echo \"${HOME}\"\n"},
+				},
+			},
+		},
+		{
+			resourceName: "spotinst_multai_listener",
+			label:        "ls-12345678",
+			id:           "ls-12345678",
+			config: map[string]interface{}{
+				"balancer_id": "lb-12345678",
+				"port":        443,
+				"protocol":    "HTTPS",
+			},
+		},
+	}
+
+	expected := `import {
+  to = spotinst_multai_balancer.web
+  id = "lb-12345678"
+}
+
+resource "spotinst_multai_balancer" "web" {
+  dns_cname_aliases = ["a.example.com", "b.example.com"]
+  name              = "web"
+
+  connection_timeouts {
+    draining = 20
+    idle     = 10
+  }
+
+  tags {
+    key   = "env"
+    value = "prod"
+  }
+
+  tags {
+    key   = "script"
+    value = "echo \"$${HOME}\"\n"
+  }
+}
+
+import {
+  to = spotinst_multai_listener.ls-12345678
+  id = "ls-12345678"
+}
+
+resource "spotinst_multai_listener" "ls-12345678" {
+  balancer_id = spotinst_multai_balancer.web.id
+  port        = 443
+  protocol    = "HTTPS"
+}
+`
+
+	var b strings.Builder
+	if err := writeGeneratedConfig(&b, resources); err != nil {
+		t.Fatalf("failed to write the generated configuration: %v", err)
+	}
+	if b.String() != expected {
+		t.Errorf("unexpected configuration, got:\n%s\nwant:\n%s", b.String(), expected)
+	}
+}
+
+func TestGenerateLabel(t *testing.T) {
+	labels := make(map[string]bool)
+	cases := []struct {
+		resource namedResource
+		label    string
+	}{
+		{resource: namedResource{id: "sig-1", name: "Web Servers (prod)"}, label: "web_servers_prod"},
+		{resource: namedResource{id: "sig-2", name: "web servers prod"}, label: "web_servers_prod_2"},
+		{resource: namedResource{id: "sig-3", name: "2nd-group"}, label: "r_2nd-group"},
+		{resource: namedResource{id: "ls-12345678"}, label: "ls-12345678"},
+		{resource: namedResource{id: "sig-4", name: "***"}, label: "r_"},
+	}
+	for _, c := range cases {
+		if label := generateLabel(c.resource, labels); label != c.label {
+			t.Errorf("generateLabel(%+v) = %q, want %q", c.resource, label, c.label)
+		}
+	}
+}
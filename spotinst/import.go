@@ -0,0 +1,138 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// childImporter describes how a resource nested under a parent resource is
+// imported.
+type childImporter struct {
+	// parentAttribute is the attribute holding the ID of the parent.
+	parentAttribute string
+
+	// parentOf reads the child and returns the ID of its parent.
+	parentOf func(ctx context.Context, childID string, meta interface{}) (string, error)
+}
+
+// importChildStateContext returns an importer accepting `<parent_id>/<child_id>`
+// IDs, as well as plain child IDs. The parent of the imported child must match
+// the one of the import ID.
+func importChildStateContext(importer childImporter) schema.StateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parentID, childID, err := parseChildImportID(resourceData.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		if parentID != "" {
+			actualParentID, err := importer.parentOf(ctx, childID, meta)
+			if err != nil {
+				return nil, err
+			}
+			if actualParentID != parentID {
+				return nil, fmt.Errorf("%q belongs to %s %q, not %q", childID, importer.parentAttribute, actualParentID, parentID)
+			}
+		}
+
+		resourceData.SetId(childID)
+		return []*schema.ResourceData{resourceData}, nil
+	}
+}
+
+// importWithDefaults returns an importer setting the values of the attributes
+// that cannot be read from the API, before calling the given importer.
+func importWithDefaults(defaults map[string]interface{}, importState schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if err := setImportDefaults(resourceData, defaults); err != nil {
+			return nil, err
+		}
+		return importState(ctx, resourceData, meta)
+	}
+}
+
+func setImportDefaults(resourceData *schema.ResourceData, defaults map[string]interface{}) error {
+	for key, value := range defaults {
+		if err := resourceData.Set(key, value); err != nil {
+			return fmt.Errorf("failed to set the default of %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// parseChildImportID splits an import ID of the form `<parent_id>/<child_id>`.
+// The parent ID is empty for a plain child ID.
+func parseChildImportID(importID string) (parentID string, childID string, err error) {
+	parts := strings.Split(importID, "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("unexpected import ID %q, expected <parent_id>/<child_id> or <child_id>", importID)
+	}
+}
+
+// importNamePrefix is the prefix of the import IDs that look a resource up by
+// its name instead of its ID.
+const importNamePrefix = "name="
+
+// namedResource is a resource returned by the list function of a named
+// importer.
+type namedResource struct {
+	id   string
+	name string
+}
+
+// importByNameStateContext returns an importer accepting `name=<name>` IDs, as
+// well as plain IDs. The resources are listed, and the one with the exact name
+// is imported. The import fails if no resource, or several, have that name.
+func importByNameStateContext(kind string, list func(ctx context.Context, meta interface{}) ([]namedResource, error)) schema.StateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if !strings.HasPrefix(resourceData.Id(), importNamePrefix) {
+			return []*schema.ResourceData{resourceData}, nil
+		}
+
+		name := strings.TrimPrefix(resourceData.Id(), importNamePrefix)
+		if name == "" {
+			return nil, fmt.Errorf("unexpected import ID %q, expected %s<name> or <id>", resourceData.Id(), importNamePrefix)
+		}
+
+		resources, err := list(ctx, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := findIDByName(kind, name, resources)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceData.SetId(id)
+		return []*schema.ResourceData{resourceData}, nil
+	}
+}
+
+// findIDByName returns the ID of the only resource with the given name.
+func findIDByName(kind string, name string, resources []namedResource) (string, error) {
+	var ids []string
+	for _, resource := range resources {
+		if resource.name == name {
+			ids = append(ids, resource.id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s found with name %q", kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d %ss with name %q (%s), import one of them by ID instead",
+			len(ids), kind, name, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,338 @@
+package spotinst
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseChildImportID(t *testing.T) {
+	cases := []struct {
+		importID string
+		parentID string
+		childID  string
+		err      bool
+	}{
+		{importID: "ols-12345678", childID: "ols-12345678"},
+		{importID: "o-12345678/ols-12345678", parentID: "o-12345678", childID: "ols-12345678"},
+		{importID: "", err: true},
+		{importID: "o-12345678/", err: true},
+		{importID: "/ols-12345678", err: true},
+		{importID: "o-12345678/ols-12345678/extra", err: true},
+	}
+	for _, c := range cases {
+		parentID, childID, err := parseChildImportID(c.importID)
+		if (err != nil) != c.err {
+			t.Errorf("parseChildImportID(%q) returned error %v, expected error: %v", c.importID, err, c.err)
+			continue
+		}
+		if parentID != c.parentID || childID != c.childID {
+			t.Errorf("parseChildImportID(%q) = (%q, %q), want (%q, %q)", c.importID, parentID, childID, c.parentID, c.childID)
+		}
+	}
+}
+
+func TestImport_ZeroDiff(t *testing.T) {
+	cases := []struct {
+		resourceName string
+		config       map[string]interface{}
+
+		// parentAttribute holds the parent ID in the config, when the
+		// resource is imported with a `<parent_id>/<child_id>` ID.
+		parentAttribute string
+
+		// terraformOnly holds the blocks only used by Terraform, which are
+		// not imported. Adding them to the config plans no other change.
+		terraformOnly map[string]interface{}
+	}{
+		{
+			resourceName: "spotinst_subscription",
+			config: map[string]interface{}{
+				"resource_id": "sig-12345678",
+				"event_type":  "AWS_EC2_INSTANCE_LAUNCH",
+				"protocol":    "http",
+				"endpoint":    "http://test.me",
+			},
+		},
+		{
+			resourceName: "spotinst_ocean_aws_launch_spec",
+			config: map[string]interface{}{
+				"ocean_id": "o-12345678",
+				"name":     "fake-launch-spec",
+				"image_id": "ami-12345678",
+			},
+			parentAttribute: "ocean_id",
+			terraformOnly: map[string]interface{}{
+				"update_policy":  []interface{}{map[string]interface{}{"should_roll": false}},
+				"delete_options": []interface{}{map[string]interface{}{"force_delete": false}},
+			},
+		},
+		{
+			resourceName: "spotinst_ocean_ecs_launch_spec",
+			config: map[string]interface{}{
+				"ocean_id": "o-12345678",
+				"name":     "fake-launch-spec",
+				"image_id": "ami-12345678",
+			},
+			parentAttribute: "ocean_id",
+			terraformOnly: map[string]interface{}{
+				"update_policy": []interface{}{map[string]interface{}{"should_roll": false}},
+			},
+		},
+		{
+			resourceName: "spotinst_multai_target",
+			config: map[string]interface{}{
+				"balancer_id":   "lb-12345678",
+				"target_set_id": "ts-12345678",
+				"host":          "fake-host",
+				"weight":        1,
+			},
+			parentAttribute: "target_set_id",
+		},
+		{
+			resourceName: "spotinst_multai_listener",
+			config: map[string]interface{}{
+				"balancer_id": "lb-12345678",
+				"protocol":    "http",
+				"port":        1337,
+			},
+			parentAttribute: "balancer_id",
+		},
+	}
+
+	provider := Provider()
+	for _, c := range cases {
+		t.Run(c.resourceName, func(t *testing.T) {
+			api := newFakeAPI(t)
+			meta := api.Client()
+			r := provider.ResourcesMap[c.resourceName]
+			ctx := context.Background()
+
+			created := schema.TestResourceDataRaw(t, r.Schema, c.config)
+			if diags := r.CreateContext(ctx, created, meta); diags.HasError() {
+				t.Fatalf("create failed: %v", diags)
+			}
+
+			importIDs := []string{created.Id()}
+			if c.parentAttribute != "" {
+				importIDs = append(importIDs, c.config[c.parentAttribute].(string)+"/"+created.Id())
+			}
+			for _, importID := range importIDs {
+				imported, err := importResourceState(ctx, r, importID, meta)
+				if err != nil {
+					t.Fatalf("import of %q failed: %v", importID, err)
+				}
+				if imported.ID != created.Id() {
+					t.Fatalf("expected %q to be imported as %q, got %q", importID, created.Id(), imported.ID)
+				}
+
+				diff, err := r.Diff(ctx, imported, terraform.NewResourceConfigRaw(c.config), meta)
+				if err != nil {
+					t.Fatalf("plan after the import of %q failed: %v", importID, err)
+				}
+				if !diff.Empty() {
+					t.Fatalf("expected no changes after the import of %q, got:\n%v", importID, diff)
+				}
+			}
+
+			if len(c.terraformOnly) > 0 {
+				imported, err := importResourceState(ctx, r, created.Id(), meta)
+				if err != nil {
+					t.Fatalf("import of %q failed: %v", created.Id(), err)
+				}
+
+				config := make(map[string]interface{})
+				for key, value := range c.config {
+					config[key] = value
+				}
+				for key, value := range c.terraformOnly {
+					config[key] = value
+				}
+				diff, err := r.Diff(ctx, imported, terraform.NewResourceConfigRaw(config), meta)
+				if err != nil {
+					t.Fatalf("plan after the import failed: %v", err)
+				}
+				if diff.Empty() {
+					t.Fatalf("expected the blocks used by Terraform to be planned after the import")
+				}
+				for name := range diff.Attributes {
+					if _, ok := c.terraformOnly[strings.SplitN(name, ".", 2)[0]]; !ok {
+						t.Fatalf("expected only the blocks used by Terraform to change after the import, got %s", name)
+					}
+				}
+			}
+
+			if c.parentAttribute != "" {
+				_, err := importResourceState(ctx, r, "wrong-parent/"+created.Id(), meta)
+				if err == nil || !strings.Contains(err.Error(), "not \"wrong-parent\"") {
+					t.Fatalf("expected the import of a child of another parent to fail, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestFindIDByName(t *testing.T) {
+	resources := []namedResource{
+		{id: "sig-11111111", name: "foo"},
+		{id: "sig-22222222", name: "bar"},
+		{id: "sig-33333333", name: "bar"},
+		{id: "sig-44444444", name: "foo-bar"},
+	}
+
+	id, err := findIDByName("Elastigroup", "foo", resources)
+	if err != nil || id != "sig-11111111" {
+		t.Errorf("findIDByName(foo) = (%q, %v), want (%q, nil)", id, err, "sig-11111111")
+	}
+
+	_, err = findIDByName("Elastigroup", "baz", resources)
+	if err == nil || !strings.Contains(err.Error(), `no Elastigroup found with name "baz"`) {
+		t.Errorf("expected no Elastigroup to be found, got %v", err)
+	}
+
+	_, err = findIDByName("Elastigroup", "bar", resources)
+	if err == nil || !strings.Contains(err.Error(), "found 2 Elastigroups") ||
+		!strings.Contains(err.Error(), "sig-22222222, sig-33333333") {
+		t.Errorf("expected several Elastigroups to be found, got %v", err)
+	}
+}
+
+func TestImport_ByName(t *testing.T) {
+	cases := []struct {
+		resourceName string
+		path         string
+	}{
+		{resourceName: "spotinst_elastigroup_aws", path: "/aws/ec2/group"},
+		{resourceName: "spotinst_elastigroup_azure_v3", path: "/azure/compute/group"},
+		{resourceName: "spotinst_elastigroup_gcp", path: "/gcp/gce/group"},
+		{resourceName: "spotinst_ocean_aws", path: "/ocean/aws/k8s/cluster"},
+		{resourceName: "spotinst_ocean_ecs", path: "/ocean/aws/ecs/cluster"},
+		{resourceName: "spotinst_ocean_aks", path: "/ocean/azure/k8s/cluster"},
+	}
+
+	provider := Provider()
+	for _, c := range cases {
+		t.Run(c.resourceName, func(t *testing.T) {
+			api := newFakeAPI(t)
+			api.objects[c.path] = map[string]map[string]interface{}{
+				"id-1": {"id": "id-1", "name": "unique"},
+				"id-2": {"id": "id-2", "name": "duplicate"},
+				"id-3": {"id": "id-3", "name": "duplicate"},
+			}
+			meta := api.Client()
+			r := provider.ResourcesMap[c.resourceName]
+			ctx := context.Background()
+
+			importIDs := map[string]string{
+				"name=unique":               "id-1",
+				"act-fake:name=unique":      "id-1",
+				"id-2":                      "id-2",
+				"act-fake:id-3":             "id-3",
+				"name=name=with-the-prefix": "",
+				"name=duplicate":            "",
+				"name=":                     "",
+			}
+			for importID, expectedID := range importIDs {
+				resourceData := r.Data(nil)
+				resourceData.SetId(importID)
+
+				imported, err := r.Importer.StateContext(ctx, resourceData, meta)
+				if expectedID == "" {
+					if err == nil {
+						t.Errorf("expected the import of %q to fail, got %q", importID, imported[0].Id())
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("import of %q failed: %v", importID, err)
+					continue
+				}
+				if imported[0].Id() != expectedID {
+					t.Errorf("expected %q to be imported as %q, got %q", importID, expectedID, imported[0].Id())
+				}
+			}
+		})
+	}
+}
+
+func TestImport_OceanRollRequired(t *testing.T) {
+	cases := []struct {
+		resourceName string
+		config       map[string]interface{}
+	}{
+		{
+			resourceName: "spotinst_ocean_aws",
+			config: map[string]interface{}{
+				"name":            "fake-cluster",
+				"controller_id":   "fake-controller",
+				"region":          "us-west-2",
+				"subnet_ids":      []interface{}{"subnet-123456"},
+				"security_groups": []interface{}{"sg-123456"},
+				"image_id":        "ami-123456",
+			},
+		},
+		{
+			resourceName: "spotinst_ocean_ecs",
+			config: map[string]interface{}{
+				"name":               "fake-cluster",
+				"cluster_name":       "fake-cluster",
+				"region":             "us-west-2",
+				"subnet_ids":         []interface{}{"subnet-123456"},
+				"security_group_ids": []interface{}{"sg-123456"},
+				"image_id":           "ami-123456",
+			},
+		},
+		{
+			resourceName: "spotinst_ocean_gke_import",
+			config: map[string]interface{}{
+				"cluster_name": "fake-cluster",
+				"location":     "us-central1-a",
+			},
+		},
+	}
+
+	provider := Provider()
+	for _, c := range cases {
+		t.Run(c.resourceName, func(t *testing.T) {
+			api := newFakeAPI(t)
+			meta := api.Client()
+			r := provider.ResourcesMap[c.resourceName]
+			ctx := context.Background()
+			config := terraform.NewResourceConfigRaw(c.config)
+
+			diff, err := r.Diff(ctx, nil, config, meta)
+			if err != nil {
+				t.Fatalf("plan failed: %v", err)
+			}
+			created, diags := r.Apply(ctx, nil, diff, meta)
+			if diags.HasError() {
+				t.Fatalf("create failed: %v", diags)
+			}
+
+			imported, err := importResourceState(ctx, r, created.ID, meta)
+			if err != nil {
+				t.Fatalf("import failed: %v", err)
+			}
+			if got := imported.Attributes["roll_required"]; got != "false" {
+				t.Fatalf("expected the import to set roll_required to false, got %q", got)
+			}
+
+			// The roll of the last update is unknown after the import, and the
+			// first plan must not show it as a change.
+			diff, err = r.Diff(ctx, imported, config, meta)
+			if err != nil {
+				t.Fatalf("plan after the import failed: %v", err)
+			}
+			if diff != nil {
+				for name, attribute := range diff.Attributes {
+					if strings.HasPrefix(name, "roll_required") {
+						t.Fatalf("expected no change of the roll attributes after the import, got %s: %#v", name, attribute)
+					}
+				}
+			}
+		})
+	}
+}
@@ -57,6 +57,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			listenerWrapper := resourceObject.(*commons.MultaiListenerWrapper)
+			listener := listenerWrapper.GetMultaiListener()
+			var value *int = nil
+			if listener.Port != nil {
+				value = listener.Port
+			}
+			if err := resourceData.Set(string(Port), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Port), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -90,6 +99,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			listenerWrapper := resourceObject.(*commons.MultaiListenerWrapper)
+			listener := listenerWrapper.GetMultaiListener()
+			var value *string = nil
+			if listener.Protocol != nil {
+				value = spotinst.String(strings.ToUpper(spotinst.StringValue(listener.Protocol)))
+			}
+			if err := resourceData.Set(string(Protocol), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Protocol), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -221,6 +239,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			listenerWrapper := resourceObject.(*commons.MultaiListenerWrapper)
+			listener := listenerWrapper.GetMultaiListener()
+			var tags []interface{} = nil
+			if listener.Tags != nil {
+				tags = flattenTags(listener.Tags)
+			}
+			if err := resourceData.Set(string(Tags), tags); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -125,6 +125,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			targetWrapper := resourceObject.(*commons.MultaiTargetWrapper)
+			target := targetWrapper.GetMultaiTarget()
+			var value *string = nil
+			if target.Host != nil {
+				value = target.Host
+			}
+			if err := resourceData.Set(string(Host), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Host), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -154,6 +163,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			targetWrapper := resourceObject.(*commons.MultaiTargetWrapper)
+			target := targetWrapper.GetMultaiTarget()
+			var value *string = nil
+			if target.Name != nil {
+				value = target.Name
+			}
+			if err := resourceData.Set(string(Name), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Name), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -183,6 +201,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			targetWrapper := resourceObject.(*commons.MultaiTargetWrapper)
+			target := targetWrapper.GetMultaiTarget()
+			var value *int = nil
+			if target.Weight != nil {
+				value = target.Weight
+			}
+			if err := resourceData.Set(string(Weight), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Weight), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -225,6 +252,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			targetWrapper := resourceObject.(*commons.MultaiTargetWrapper)
+			target := targetWrapper.GetMultaiTarget()
+			var tags []interface{} = nil
+			if target.Tags != nil {
+				tags = flattenTags(target.Tags)
+			}
+			if err := resourceData.Set(string(Tags), tags); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
+			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
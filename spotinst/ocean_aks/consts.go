@@ -10,3 +10,14 @@ const (
 	AKSResourceGroupName commons.FieldName = "aks_resource_group_name"
 	Zones                commons.FieldName = "zones"
 )
+
+const (
+	UpdatePolicy commons.FieldName = "update_policy"
+	ShouldRoll   commons.FieldName = "should_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	RespectPDB                commons.FieldName = "respect_pdb"
+	VNGIDs                    commons.FieldName = "vng_ids"
+)
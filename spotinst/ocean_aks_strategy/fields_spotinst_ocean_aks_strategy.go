@@ -23,7 +23,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:         schema.TypeInt,
 						Optional:     true,
 						Default:      -1,
-						ValidateFunc: validation.IntAtLeast(-1),
+						ValidateFunc: validation.IntBetween(-1, 100),
 					},
 					string(FallbackToOnDemand): {
 						Type:     schema.TypeBool,
@@ -25,3 +25,13 @@ const (
 	ResourceLimits   commons.FieldName = "resource_limits"
 	MaxInstanceCount commons.FieldName = "max_instance_count"
 )
+
+const (
+	UpdatePolicy commons.FieldName = "update_policy"
+	ShouldRoll   commons.FieldName = "should_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	RespectPDB                commons.FieldName = "respect_pdb"
+)
@@ -20,7 +20,8 @@ const (
 	Region    commons.FieldName = "region"
 	SubnetIDs commons.FieldName = "subnet_ids"
 
-	Tags commons.FieldName = "tags"
+	Tags    commons.FieldName = "tags"
+	TagsAll commons.FieldName = "tags_all"
 
 	UpdatePolicy    commons.FieldName = "update_policy"
 	ShouldRoll      commons.FieldName = "should_roll"
@@ -31,4 +32,12 @@ const (
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	WaitForRollPct            commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout        commons.FieldName = "wait_for_roll_timeout"
+
+	RollID     commons.FieldName = "roll_id"
+	RollStatus commons.FieldName = "roll_status"
+
+	RollRequired       commons.FieldName = "roll_required"
+	RollRequiredFields commons.FieldName = "roll_required_fields"
 )
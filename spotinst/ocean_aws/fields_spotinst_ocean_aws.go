@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
@@ -231,6 +232,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, DesiredCapacity)
+		},
 	)
 
 	fieldsMap[SubnetIDs] = commons.NewGenericField(
@@ -309,6 +314,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				tags := cluster.Compute.LaunchSpecification.Tags
 				result = flattenTags(tags)
 			}
+			if err := commons.SetAllTags(resourceData, TagsAll, string(TagKey), string(TagValue), result); err != nil {
+				return err
+			}
+			result = commons.RemoveDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue), result)
 			if result != nil {
 				if err := resourceData.Set(string(Tags), result); err != nil {
 					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
@@ -319,7 +328,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -332,7 +341,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var tagsToAdd []*aws.Tag = nil
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -342,9 +351,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			cluster.Compute.LaunchSpecification.SetTags(tagsToAdd)
 			return nil
 		},
-		nil,
+		commons.HasTagsChange(Tags, TagsAll),
 	)
 
+	fieldsMap[TagsAll] = commons.NewAllTagsField(commons.OceanAWS, TagsAll, Tags, string(TagKey), string(TagValue))
+
 	fieldsMap[UpdatePolicy] = commons.NewGenericField(
 		commons.OceanAWS,
 		UpdatePolicy,
@@ -374,8 +385,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(BatchSizePercentage): {
-									Type:     schema.TypeInt,
-									Required: true,
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 								string(LaunchSpecIDs): {
 									Type:     schema.TypeList,
@@ -383,6 +395,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Elem:     &schema.Schema{Type: schema.TypeString},
 								},
 								string(BatchMinHealthyPercentage): {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
+								},
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+								string(WaitForRollTimeout): {
 									Type:     schema.TypeInt,
 									Optional: true,
 								},
@@ -394,6 +415,51 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[RollRequired] = commons.NewGenericField(
+		commons.OceanAWS,
+		RollRequired,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.OceanAWSResource.OnRollDiff(resourceDiff, RollRequired, RollRequiredFields)
+		},
+	)
+
+	fieldsMap[RollRequiredFields] = commons.NewGenericField(
+		commons.OceanAWS,
+		RollRequiredFields,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[RollID] = commons.NewGenericField(
+		commons.OceanAWS,
+		RollID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[RollStatus] = commons.NewGenericField(
+		commons.OceanAWS,
+		RollStatus,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
 }
 
 func expandSubnetIDs(data interface{}) ([]string, error) {
@@ -109,6 +109,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateNoOverlap(resourceDiff, Whitelist, Blacklist)
+		},
 	)
 }
 
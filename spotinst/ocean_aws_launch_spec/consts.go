@@ -50,6 +50,7 @@ const (
 	PreferredSpotTypes       commons.FieldName = "preferred_spot_types"
 	RootVolumeSize           commons.FieldName = "root_volume_size"
 	Tags                     commons.FieldName = "tags"
+	TagsAll                  commons.FieldName = "tags_all"
 	ElasticIpPool            commons.FieldName = "elastic_ip_pool"
 	TagSelector              commons.FieldName = "tag_selector"
 	TagSelectorKey           commons.FieldName = "tag_key"
@@ -94,8 +95,9 @@ const (
 )
 
 const (
-	UpdatePolicy commons.FieldName = "update_policy"
-	ShouldRoll   commons.FieldName = "should_roll"
+	UpdatePolicy    commons.FieldName = "update_policy"
+	ShouldRoll      commons.FieldName = "should_roll"
+	ConditionedRoll commons.FieldName = "conditioned_roll"
 
 	RollConfig          commons.FieldName = "roll_config"
 	BatchSizePercentage commons.FieldName = "batch_size_percentage"
@@ -278,6 +278,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				tags := launchSpec.Tags
 				result = flattenTags(tags)
 			}
+			if err := commons.SetAllTags(resourceData, TagsAll, string(TagKey), string(TagValue), result); err != nil {
+				return err
+			}
+			result = commons.RemoveDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue), result)
 			if result != nil {
 				if err := resourceData.Set(string(Tags), result); err != nil {
 					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
@@ -288,7 +292,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			launchSpecWrapper := resourceObject.(*commons.LaunchSpecWrapper)
 			launchSpec := launchSpecWrapper.GetLaunchSpec()
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -301,7 +305,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			launchSpecWrapper := resourceObject.(*commons.LaunchSpecWrapper)
 			launchSpec := launchSpecWrapper.GetLaunchSpec()
 			var tagsToAdd []*aws.Tag = nil
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -311,9 +315,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			launchSpec.SetTags(tagsToAdd)
 			return nil
 		},
-		nil,
+		commons.HasTagsChange(Tags, TagsAll),
 	)
 
+	fieldsMap[TagsAll] = commons.NewAllTagsField(commons.OceanAWSLaunchConfiguration, TagsAll, Tags, string(TagKey), string(TagValue))
+
 	fieldsMap[ElasticIpPool] = commons.NewGenericField(
 		commons.OceanAWSLaunchSpec,
 		ElasticIpPool,
@@ -1123,7 +1129,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:         schema.TypeInt,
 						Optional:     true,
 						Default:      -1,
-						ValidateFunc: validation.IntAtLeast(-1),
+						ValidateFunc: validation.IntBetween(-1, 100),
 					},
 				},
 			},
@@ -1291,6 +1297,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeBool,
 						Required: true,
 					},
+					string(ConditionedRoll): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
 
 					string(RollConfig): {
 						Type:     schema.TypeList,
@@ -1299,8 +1309,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(BatchSizePercentage): {
-									Type:     schema.TypeInt,
-									Required: true,
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 							},
 						},
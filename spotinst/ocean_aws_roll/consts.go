@@ -0,0 +1,16 @@
+package ocean_aws_roll
+
+import "github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	OceanID                   commons.FieldName = "ocean_id"
+	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
+	InstanceIDs               commons.FieldName = "instance_ids"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	RespectPDB                commons.FieldName = "respect_pdb"
+	Triggers                  commons.FieldName = "triggers"
+
+	Status   commons.FieldName = "status"
+	Progress commons.FieldName = "progress"
+)
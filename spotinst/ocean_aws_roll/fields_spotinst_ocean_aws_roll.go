@@ -0,0 +1,191 @@
+package ocean_aws_roll
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+// Setup sets the fields of the roll. A roll cannot be changed once started, so
+// every argument forces a new roll. The roll status only reports the cluster
+// of the roll, the other arguments are kept as configured.
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	fieldsMap[OceanID] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		OceanID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			if roll.ClusterID == nil {
+				return nil
+			}
+			if err := resourceData.Set(string(OceanID), spotinst.StringValue(roll.ClusterID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(OceanID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			roll.ClusterID = spotinst.String(resourceData.Get(string(OceanID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[LaunchSpecIDs] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		LaunchSpecIDs,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{string(InstanceIDs)},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			if v, ok := resourceData.GetOk(string(LaunchSpecIDs)); ok {
+				roll.LaunchSpecIDs = expandIDs(v)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[InstanceIDs] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		InstanceIDs,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{string(LaunchSpecIDs)},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			if v, ok := resourceData.GetOk(string(InstanceIDs)); ok {
+				roll.InstanceIDs = expandIDs(v)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[BatchSizePercentage] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		BatchSizePercentage,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			roll.BatchSizePercentage = spotinst.Int(resourceData.Get(string(BatchSizePercentage)).(int))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[BatchMinHealthyPercentage] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		BatchMinHealthyPercentage,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			if v, ok := resourceData.GetOk(string(BatchMinHealthyPercentage)); ok {
+				roll.BatchMinHealthyPercentage = spotinst.Int(v.(int))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[RespectPDB] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		RespectPDB,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			roll := resourceObject.(*commons.OceanAWSRollWrapper).GetOceanAWSRoll()
+			if v, ok := resourceData.GetOk(string(RespectPDB)); ok && v.(bool) {
+				roll.RespectPDB = spotinst.Bool(true)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	// Triggers are not sent, they only force a new roll when they change.
+	fieldsMap[Triggers] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		Triggers,
+		&schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Progress] = commons.NewGenericField(
+		commons.OceanAWSRoll,
+		Progress,
+		&schema.Schema{
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+}
+
+func expandIDs(data interface{}) []string {
+	list := data.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if id, ok := v.(string); ok && id != "" {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -20,6 +20,10 @@ const (
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
 	Tags                      commons.FieldName = "tags"
+	TagsAll                   commons.FieldName = "tags_all"
 	TagKey                    TagField          = "key"
 	TagValue                  TagField          = "value"
+
+	RollRequired       commons.FieldName = "roll_required"
+	RollRequiredFields commons.FieldName = "roll_required_fields"
 )
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
@@ -228,6 +229,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, DesiredCapacity)
+		},
 	)
 
 	fieldsMap[SubnetIDs] = commons.NewGenericField(
@@ -306,12 +311,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(BatchSizePercentage): {
-									Type:     schema.TypeInt,
-									Required: true,
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 								string(BatchMinHealthyPercentage): {
-									Type:     schema.TypeInt,
-									Optional: true,
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 							},
 						},
@@ -322,6 +329,31 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil, nil, nil, nil,
 	)
 
+	fieldsMap[RollRequired] = commons.NewGenericField(
+		commons.OceanECS,
+		RollRequired,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.OceanECSResource.OnRollDiff(resourceDiff, RollRequired, RollRequiredFields)
+		},
+	)
+
+	fieldsMap[RollRequiredFields] = commons.NewGenericField(
+		commons.OceanECS,
+		RollRequiredFields,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+
 	fieldsMap[Tags] = commons.NewGenericField(
 		commons.OceanECS,
 		Tags,
@@ -351,6 +383,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 				tags := cluster.Compute.LaunchSpecification.Tags
 				result = flattenTags(tags)
 			}
+			if err := commons.SetAllTags(resourceData, TagsAll, string(TagKey), string(TagValue), result); err != nil {
+				return err
+			}
+			result = commons.RemoveDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue), result)
 			if result != nil {
 				if err := resourceData.Set(string(Tags), result); err != nil {
 					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Tags), err)
@@ -361,7 +397,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -374,7 +410,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var tagsToAdd []*aws.Tag = nil
-			if value, ok := resourceData.GetOk(string(Tags)); ok {
+			if value, ok := commons.GetOkWithDefaultTags(resourceData, meta, Tags, string(TagKey), string(TagValue)); ok {
 				if tags, err := expandTags(value); err != nil {
 					return err
 				} else {
@@ -384,8 +420,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			cluster.Compute.LaunchSpecification.SetTags(tagsToAdd)
 			return nil
 		},
-		nil,
+		commons.HasTagsChange(Tags, TagsAll),
 	)
+
+	fieldsMap[TagsAll] = commons.NewAllTagsField(commons.OceanECS, TagsAll, Tags, string(TagKey), string(TagValue))
 }
 
 func expandSubnetIDs(data interface{}) ([]string, error) {
@@ -24,6 +24,7 @@ const (
 	AttributeValue     commons.FieldName = "value"
 	AutoscaleHeadrooms commons.FieldName = "autoscale_headrooms"
 	Tags               commons.FieldName = "tags"
+	TagsAll            commons.FieldName = "tags_all"
 	InstanceTypes      commons.FieldName = "instance_types"
 	RestrictScaleDown  commons.FieldName = "restrict_scale_down"
 	SubnetIDs          commons.FieldName = "subnet_ids"
@@ -66,3 +67,13 @@ const (
 	TaskType       commons.FieldName = "task_type"
 	TaskHeadroom   commons.FieldName = "task_headroom"
 )
+
+const (
+	UpdatePolicy    commons.FieldName = "update_policy"
+	ShouldRoll      commons.FieldName = "should_roll"
+	ConditionedRoll commons.FieldName = "conditioned_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+)
@@ -154,7 +154,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Type:         schema.TypeInt,
 			Optional:     true,
 			Default:      -1,
-			ValidateFunc: validation.IntAtLeast(-1),
+			ValidateFunc: validation.IntBetween(-1, 100),
 			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 				if old == "-1" && new == "null" {
 					return true
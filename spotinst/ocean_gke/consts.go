@@ -19,6 +19,15 @@ const (
 	NamedPorts      commons.FieldName = "named_ports"
 	Ports           commons.FieldName = "ports"
 	ServiceName     commons.FieldName = "service_name"
+
+	UpdatePolicy    commons.FieldName = "update_policy"
+	ShouldRoll      commons.FieldName = "should_roll"
+	ConditionedRoll commons.FieldName = "conditioned_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
 )
 
 type LabelField string
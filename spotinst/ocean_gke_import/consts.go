@@ -29,4 +29,7 @@ const (
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+
+	RollRequired       commons.FieldName = "roll_required"
+	RollRequiredFields commons.FieldName = "roll_required_fields"
 )
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
@@ -311,6 +312,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.ValidateCapacity(resourceDiff, MinSize, MaxSize, DesiredCapacity)
+		},
 	)
 
 	fieldsMap[ClusterControllerID] = commons.NewGenericField(
@@ -379,8 +384,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(BatchSizePercentage): {
-									Type:     schema.TypeInt,
-									Required: true,
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 								string(LaunchSpecIDs): {
 									Type:     schema.TypeList,
@@ -388,8 +394,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Elem:     &schema.Schema{Type: schema.TypeString},
 								},
 								string(BatchMinHealthyPercentage): {
-									Type:     schema.TypeInt,
-									Optional: true,
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 100),
 								},
 							},
 						},
@@ -399,6 +406,31 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[RollRequired] = commons.NewGenericField(
+		commons.OceanGKEImport,
+		RollRequired,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	).WithDiff(
+		func(resourceDiff *schema.ResourceDiff, meta interface{}) error {
+			return commons.OceanGKEImportResource.OnRollDiff(resourceDiff, RollRequired, RollRequiredFields)
+		},
+	)
+
+	fieldsMap[RollRequiredFields] = commons.NewGenericField(
+		commons.OceanGKEImport,
+		RollRequiredFields,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
 }
 
 func expandServices(data interface{}) ([]*gcp.BackendService, error) {
@@ -57,8 +57,9 @@ const (
 )
 
 const (
-	UpdatePolicy commons.FieldName = "update_policy"
-	ShouldRoll   commons.FieldName = "should_roll"
+	UpdatePolicy    commons.FieldName = "update_policy"
+	ShouldRoll      commons.FieldName = "should_roll"
+	ConditionedRoll commons.FieldName = "conditioned_roll"
 
 	RollConfig          commons.FieldName = "roll_config"
 	BatchSizePercentage commons.FieldName = "batch_size_percentage"
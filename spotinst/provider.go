@@ -4,6 +4,9 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/featureflag"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
@@ -12,25 +15,96 @@ func Provider() *schema.Provider {
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			string(commons.ProviderToken): {
-				Type:     schema.TypeString,
-				Optional: true,
-				//DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarToken, ""),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarToken, ""),
 				Description: "Spotinst Personal API Access Token",
 			},
 
 			string(commons.ProviderAccount): {
-				Type:     schema.TypeString,
-				Optional: true,
-				//DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarAccount, ""),
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarAccount, ""),
 				Description: "Spotinst Account ID",
 			},
 
 			string(commons.ProviderFeatureFlags): {
-				Type:     schema.TypeString,
-				Optional: true,
-				//DefaultFunc: schema.EnvDefaultFunc(featureflag.EnvVar, ""),
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(featureflag.EnvVar, ""),
 				Description: "Spotinst SDK Feature Flags",
 			},
+
+			// The profile and the credentials file have no default. When they
+			// are set in the environment, the credentials file is read after
+			// the token and account of the provider block, see getCredentials.
+			string(commons.ProviderProfile): {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the profile to load from the Spotinst credentials file",
+			},
+
+			string(commons.ProviderCredsFile): {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to the Spotinst credentials file",
+			},
+
+			string(commons.ProviderEndpoint): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(EnvVarEndpoint, ""),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Base URL of the Spotinst API",
+			},
+
+			string(commons.ProviderCABundle): {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(EnvVarCABundle, ""),
+				Description: "Path to a PEM-encoded CA bundle used to verify the certificate of the Spotinst API",
+			},
+
+			string(commons.ProviderHTTPProxy): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(EnvVarHTTPProxy, ""),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL of the HTTP proxy used to reach the Spotinst API",
+			},
+
+			string(commons.ProviderMaxRetries): {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of times a throttled or failed API request is retried",
+			},
+
+			string(commons.ProviderMaxBackoff): {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      DefaultMaxBackoff,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum delay, in seconds, between two attempts of an API request",
+			},
+
+			string(commons.ProviderDefaultTags): {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags applied to every taggable resource managed by the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(commons.ProviderTags): {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -47,6 +121,8 @@ func Provider() *schema.Provider {
 			// Ocean.
 			string(commons.OceanAWSResourceName):                 resourceSpotinstOceanAWS(),
 			string(commons.OceanAWSLaunchSpecResourceName):       resourceSpotinstOceanAWSLaunchSpec(),
+			string(commons.OceanAWSRollResourceName):             resourceSpotinstOceanAWSRoll(),
+			string(commons.OceanGKEResourceName):                 resourceSpotinstOceanGKE(),
 			string(commons.OceanGKEImportResourceName):           resourceSpotinstOceanGKEImport(),
 			string(commons.OceanGKELaunchSpecResourceName):       resourceSpotinstOceanGKELaunchSpec(),
 			string(commons.OceanGKELaunchSpecImportResourceName): resourceSpotinstOceanGKELaunchSpecImport(),
@@ -81,6 +157,22 @@ func Provider() *schema.Provider {
 			// Stateful
 			string(commons.StatefulNodeAzureResourceName): resourceSpotinstStatefulNodeAzureV3(),
 		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			// Elastigroup.
+			string(commons.ElastigroupAWSResourceName): dataSourceSpotinstElastigroupAWS(),
+
+			// Ocean.
+			string(commons.OceanAWSResourceName):              dataSourceSpotinstOceanAWS(),
+			string(commons.OceanAWSLaunchSpecsDataSourceName): dataSourceSpotinstOceanAWSLaunchSpecs(),
+		},
+	}
+
+	for _, r := range p.ResourcesMap {
+		withAccountID(r, true)
+	}
+	for _, r := range p.DataSourcesMap {
+		withAccountID(r, false)
 	}
 
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -101,8 +193,29 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		Token:            d.Get(string(commons.ProviderToken)).(string),
 		Account:          d.Get(string(commons.ProviderAccount)).(string),
 		FeatureFlags:     d.Get(string(commons.ProviderFeatureFlags)).(string),
+		Profile:          d.Get(string(commons.ProviderProfile)).(string),
+		CredentialsFile:  d.Get(string(commons.ProviderCredsFile)).(string),
+		Endpoint:         d.Get(string(commons.ProviderEndpoint)).(string),
+		CABundle:         d.Get(string(commons.ProviderCABundle)).(string),
+		HTTPProxy:        d.Get(string(commons.ProviderHTTPProxy)).(string),
+		MaxRetries:       d.Get(string(commons.ProviderMaxRetries)).(int),
+		MaxBackoff:       d.Get(string(commons.ProviderMaxBackoff)).(int),
+		DefaultTags:      expandProviderDefaultTags(d.Get(string(commons.ProviderDefaultTags)).([]interface{})),
 		terraformVersion: terraformVersion,
 	}
 
 	return config.Client()
 }
+
+func expandProviderDefaultTags(list []interface{}) map[string]string {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+	tags := make(map[string]string)
+	for key, value := range m[string(commons.ProviderTags)].(map[string]interface{}) {
+		tags[key] = value.(string)
+	}
+	return tags
+}
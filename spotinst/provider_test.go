@@ -3,10 +3,15 @@ package spotinst
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
 )
 
 var TestAccProviders map[string]*schema.Provider
@@ -45,7 +50,201 @@ func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
 
+func TestConfig_Endpoint(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":{"items":[]}}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:    "fake",
+		Account:  "fake",
+		Endpoint: server.URL,
+	}
+
+	spotinstClient, diags := config.Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	r := client.NewRequest(http.MethodGet, "/aws/ec2/group")
+	if err := doAPIRequest(context.Background(), r, spotinstClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requested != "/aws/ec2/group" {
+		t.Fatalf("expected the request to reach the custom endpoint, got %q", requested)
+	}
+}
+
+func TestClient_ForAccount(t *testing.T) {
+	var accounts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accounts = append(accounts, r.URL.Query().Get("accountId"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":{"items":[]}}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:    "fake",
+		Account:  "act-default",
+		Endpoint: server.URL,
+	}
+
+	spotinstClient, diags := config.Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	accountClient := spotinstClient.forAccount("act-other")
+	if accountClient != spotinstClient.forAccount("act-other") {
+		t.Fatal("expected the account client to be cached")
+	}
+
+	for _, c := range []*Client{spotinstClient, accountClient} {
+		r := client.NewRequest(http.MethodGet, "/aws/ec2/group")
+		if err := doAPIRequest(context.Background(), r, c); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(accounts) != 2 || accounts[0] != "act-default" || accounts[1] != "act-other" {
+		t.Fatalf("expected requests to accounts [act-default act-other], got %v", accounts)
+	}
+}
+
+func TestParseAccountImportID(t *testing.T) {
+	cases := []struct {
+		importID string
+		account  string
+		id       string
+		ok       bool
+	}{
+		{"act-12345678:sig-12345678", "act-12345678", "sig-12345678", true},
+		{"sig-12345678", "", "sig-12345678", false},
+		{"o-12345678:ols-12345678", "", "o-12345678:ols-12345678", false},
+		{"act-12345678:", "", "act-12345678:", false},
+	}
+
+	for _, c := range cases {
+		account, id, ok := parseAccountImportID(c.importID)
+		if account != c.account || id != c.id || ok != c.ok {
+			t.Errorf("parseAccountImportID(%q) = (%q, %q, %v), expected (%q, %q, %v)",
+				c.importID, account, id, ok, c.account, c.id, c.ok)
+		}
+	}
+}
+
+func TestConfig_CABundle(t *testing.T) {
+	config := Config{
+		Token:    "fake",
+		CABundle: filepath.Join(t.TempDir(), "missing.pem"),
+	}
+
+	if _, diags := config.Client(); !diags.HasError() {
+		t.Fatal("expected an error for a missing CA bundle")
+	}
+}
+
+func TestConfig_Profile(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":{"items":[]}}`))
+	}))
+	defer server.Close()
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\ntoken = default-token\n\n[staging]\ntoken = staging-token\naccount = act-staging\n"
+	if err := os.WriteFile(credentialsFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := Config{
+		Token:           "env-token",
+		Profile:         "staging",
+		CredentialsFile: credentialsFile,
+		Endpoint:        server.URL,
+	}
+
+	spotinstClient, diags := config.Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	r := client.NewRequest(http.MethodGet, "/aws/ec2/group")
+	if err := doAPIRequest(context.Background(), r, spotinstClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authorization != "Bearer staging-token" {
+		t.Fatalf("expected the token of the selected profile, got %q", authorization)
+	}
+
+	config.Profile = "missing"
+	if _, diags := config.Client(); !diags.HasError() {
+		t.Fatal("expected an error for a missing profile")
+	}
+}
+
+func TestProvider_ProfileFromEnvironment(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"response":{"items":[]}}`))
+	}))
+	defer server.Close()
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	content := "[staging]\ntoken = staging-token\naccount = act-staging\n"
+	if err := os.WriteFile(credentialsFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	setenvForTest(t, credentials.EnvCredentialsVarToken, "")
+	setenvForTest(t, credentials.EnvCredentialsVarAccount, "")
+	setenvForTest(t, credentials.FileCredentialsEnvVarProfile, "staging")
+	setenvForTest(t, credentials.FileCredentialsEnvVarFile, credentialsFile)
+
+	configure := func(raw map[string]interface{}) *Client {
+		t.Helper()
+		raw["endpoint"] = server.URL
+		d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+		meta, diags := providerConfigure(d, "test")
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return meta.(*Client)
+	}
+	authorize := func(spotinstClient *Client) string {
+		t.Helper()
+		r := client.NewRequest(http.MethodGet, "/aws/ec2/group")
+		if err := doAPIRequest(context.Background(), r, spotinstClient); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return authorization
+	}
+
+	// The token of the provider block wins over a profile of the environment.
+	if got := authorize(configure(map[string]interface{}{"token": "provider-token"})); got != "Bearer provider-token" {
+		t.Fatalf("expected the token of the provider block, got %q", got)
+	}
+
+	// Without a token, the profile of the environment is used.
+	if got := authorize(configure(map[string]interface{}{})); got != "Bearer staging-token" {
+		t.Fatalf("expected the token of the profile of the environment, got %q", got)
+	}
+}
+
 func testAccPreCheck(t *testing.T, provider string) {
+	if os.Getenv(fakeAPIEnvVar) != "" {
+		useFakeAPI(t)
+		return
+	}
+
 	tokens := map[string]string{
 		"gcp":   os.Getenv("SPOTINST_TOKEN_GCP"),
 		"aws":   os.Getenv("SPOTINST_TOKEN_AWS"),
@@ -59,8 +258,9 @@ func testAccPreCheck(t *testing.T, provider string) {
 
 func providerConfigureGCP(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Token:   os.Getenv("SPOTINST_TOKEN_GCP"),
-		Account: os.Getenv("SPOTINST_ACCOUNT_GCP"),
+		Token:      os.Getenv("SPOTINST_TOKEN_GCP"),
+		Account:    os.Getenv("SPOTINST_ACCOUNT_GCP"),
+		MaxRetries: DefaultMaxRetries,
 	}
 
 	return config.Client()
@@ -68,8 +268,9 @@ func providerConfigureGCP(context.Context, *schema.ResourceData) (interface{}, d
 
 func providerConfigureAWS(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Token:   os.Getenv("SPOTINST_TOKEN_AWS"),
-		Account: os.Getenv("SPOTINST_ACCOUNT_AWS"),
+		Token:      os.Getenv("SPOTINST_TOKEN_AWS"),
+		Account:    os.Getenv("SPOTINST_ACCOUNT_AWS"),
+		MaxRetries: DefaultMaxRetries,
 	}
 
 	return config.Client()
@@ -77,8 +278,9 @@ func providerConfigureAWS(context.Context, *schema.ResourceData) (interface{}, d
 
 func providerConfigureAzure(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Token:   os.Getenv("SPOTINST_TOKEN_AZURE"),
-		Account: os.Getenv("SPOTINST_ACCOUNT_AZURE"),
+		Token:      os.Getenv("SPOTINST_TOKEN_AZURE"),
+		Account:    os.Getenv("SPOTINST_ACCOUNT_AZURE"),
+		MaxRetries: DefaultMaxRetries,
 	}
 
 	return config.Client()
@@ -12,7 +12,6 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/dataintegration"
 	"log"
-	"time"
 )
 
 func resourceSpotinstDataIntegration() *schema.Resource {
@@ -28,6 +27,8 @@ func resourceSpotinstDataIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.DataIntegrationResource.GetTimeouts(),
+
 		Schema: commons.DataIntegrationResource.GetSchemaMap(),
 	}
 }
@@ -46,7 +47,7 @@ func resourceSpotinstDataIntegrationRead(ctx context.Context, resourceData *sche
 	log.Printf(string(commons.ResourceOnRead), commons.DataIntegrationResource.GetName(), resourceId)
 
 	input := &aws.ReadDataIntegrationInput{DataIntegrationId: spotinst.String(resourceId)}
-	resp, err := meta.(*Client).dataIntegration.CloudProviderAWS().ReadDataIntegration(context.Background(), input)
+	resp, err := meta.(*Client).dataIntegration.CloudProviderAWS().ReadDataIntegration(ctx, input)
 	if err != nil {
 		// If the DataIntegration was not found, return nil so that we can show
 		// that the DataIntegration does not exist
@@ -83,7 +84,7 @@ func resourceSpotinstDataIntegrationCreate(ctx context.Context, resourceData *sc
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	DataIntegrationId, err := createDataIntegration(resourceData, DataIntegration, meta.(*Client))
+	DataIntegrationId, err := createDataIntegration(ctx, resourceData, DataIntegration, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -96,16 +97,16 @@ func resourceSpotinstDataIntegrationCreate(ctx context.Context, resourceData *sc
 
 }
 
-func createDataIntegration(resourceData *schema.ResourceData, di *aws.DataIntegration, spotinstClient *Client) (*string, error) {
+func createDataIntegration(ctx context.Context, resourceData *schema.ResourceData, di *aws.DataIntegration, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(di); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> DataIntegration create configuration: %s", json)
 	}
 	var resp *aws.CreateDataIntegrationOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateDataIntegrationInput{DataIntegration: di}
-		r, err := spotinstClient.dataIntegration.CloudProviderAWS().CreateDataIntegration(context.Background(), input)
+		r, err := spotinstClient.dataIntegration.CloudProviderAWS().CreateDataIntegration(ctx, input)
 		if err != nil {
 			// Some other error, report it.
 			return resource.NonRetryableError(err)
@@ -131,7 +132,7 @@ func resourceSpotinstDataIntegrationUpdate(ctx context.Context, resourceData *sc
 
 	if shouldUpdate {
 		di.SetID(spotinst.String(resourceId))
-		if err := updateDataIntegrationResource(di, resourceData, meta); err != nil {
+		if err := updateDataIntegrationResource(ctx, di, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -139,7 +140,7 @@ func resourceSpotinstDataIntegrationUpdate(ctx context.Context, resourceData *sc
 	return resourceSpotinstDataIntegrationRead(ctx, resourceData, meta)
 }
 
-func updateDataIntegrationResource(di *aws.DataIntegration, resourceData *schema.ResourceData, meta interface{}) error {
+func updateDataIntegrationResource(ctx context.Context, di *aws.DataIntegration, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &aws.UpdateDataIntegrationInput{
 		DataIntegration: di,
 	}
@@ -151,7 +152,7 @@ func updateDataIntegrationResource(di *aws.DataIntegration, resourceData *schema
 		log.Printf("===> DataIntegration update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).dataIntegration.CloudProviderAWS().UpdateDataIntegration(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).dataIntegration.CloudProviderAWS().UpdateDataIntegration(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update DataIntegration [%v]: %v", diId, err)
 	}
 	return nil
@@ -161,7 +162,7 @@ func resourceSpotinstDataIntegrationDelete(ctx context.Context, resourceData *sc
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.DataIntegrationResource.GetName(), resourceId)
 
-	if err := deleteDataIntegration(resourceData, meta); err != nil {
+	if err := deleteDataIntegration(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -170,7 +171,7 @@ func resourceSpotinstDataIntegrationDelete(ctx context.Context, resourceData *sc
 	return nil
 }
 
-func deleteDataIntegration(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteDataIntegration(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	diId := resourceData.Id()
 	input := &aws.DeleteDataIntegrationInput{
 		DataIntegrationId: spotinst.String(diId),
@@ -181,7 +182,7 @@ func deleteDataIntegration(resourceData *schema.ResourceData, meta interface{})
 		log.Printf("===> DataIntegration delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).dataIntegration.CloudProviderAWS().DeleteDataIntegration(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).dataIntegration.CloudProviderAWS().DeleteDataIntegration(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete DataIntegration: %s", err)
 	}
 	return nil
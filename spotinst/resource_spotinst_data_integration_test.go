@@ -13,6 +13,40 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_data_integration", &resource.Sweeper{
+		Name: "spotinst_data_integration",
+		F:    testSweepDataIntegration,
+	})
+}
+
+func testSweepDataIntegration(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).dataIntegration.CloudProviderAWS()
+	input := &aws.ListDataIntegrationsInput{}
+	if resp, err := conn.ListDataIntegration(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of data integrations to sweep")
+	} else {
+		if len(resp.DataIntegrations) == 0 {
+			log.Printf("[INFO] No data integrations to sweep")
+		}
+		for _, di := range resp.DataIntegrations {
+			if isSweepable(di.Name) {
+				if _, err := conn.DeleteDataIntegration(context.Background(), &aws.DeleteDataIntegrationInput{DataIntegrationId: di.ID}); err != nil {
+					return fmt.Errorf("unable to delete data integration %v in sweep", spotinst.StringValue(di.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(di.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createDataIntegrationName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.DataIntegrationResourceName), name)
 }
@@ -123,7 +157,7 @@ func TestAccSpotinstDataIntegration_Baseline(t *testing.T) {
 
 				Check: resource.ComposeTestCheckFunc(
 					testCheckDataIntegrationExists(&di, resourceName),
-					resource.TestCheckResourceAttr(resourceName, "name", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-acc-data-integration"),
 					resource.TestCheckResourceAttr(resourceName, "status", "enabled"),
 					resource.TestCheckResourceAttr(resourceName, "s3.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "s3.0.bucket_name", "terraform-test-do-not-delete"),
@@ -137,7 +171,7 @@ func TestAccSpotinstDataIntegration_Baseline(t *testing.T) {
 					updateBaselineFields: true}, testBaselineDataIntegrationConfig_Update),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckDataIntegrationExists(&di, resourceName),
-					resource.TestCheckResourceAttr(resourceName, "name", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-acc-data-integration"),
 					resource.TestCheckResourceAttr(resourceName, "status", "disabled"),
 					resource.TestCheckResourceAttr(resourceName, "s3.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "s3.0.bucket_name", "terraform-test-do-not-delete-2"),
@@ -151,7 +185,7 @@ func TestAccSpotinstDataIntegration_Baseline(t *testing.T) {
 const testBaselineDataIntegrationConfig_Create = `
 resource "` + string(commons.DataIntegrationResourceName) + `" "%v" {
   provider = "%v"
-  name  = "foo"
+  name  = "test-acc-data-integration"
   status = "enabled"
   s3 {
   	bucket_name = "terraform-test-do-not-delete"
@@ -163,7 +197,7 @@ resource "` + string(commons.DataIntegrationResourceName) + `" "%v" {
 const testBaselineDataIntegrationConfig_Update = `
 resource "` + string(commons.DataIntegrationResourceName) + `" "%v" {
   provider = "%v"
-  name  = "foo"
+  name  = "test-acc-data-integration"
   status = "disabled"
   s3 {
     bucket_name = "terraform-test-do-not-delete-2"
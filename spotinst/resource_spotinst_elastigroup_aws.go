@@ -37,9 +37,13 @@ func resourceSpotinstElastigroupAWS() *schema.Resource {
 		DeleteContext: resourceSpotinstElastigroupAWSDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByNameStateContext("Elastigroup", listElastigroupAWSNames),
 		},
 
+		Timeouts: commons.ElastigroupResource.GetTimeouts(),
+
+		CustomizeDiff: commons.ElastigroupResource.OnDiff,
+
 		Schema: commons.ElastigroupResource.GetSchemaMap(),
 	}
 }
@@ -66,7 +70,7 @@ func resourceSpotinstElastigroupAWSDelete(ctx context.Context, resourceData *sch
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupResource.GetName(), id)
 
-	if err := deleteGroup(resourceData, meta); err != nil {
+	if err := deleteGroup(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -75,7 +79,7 @@ func resourceSpotinstElastigroupAWSDelete(ctx context.Context, resourceData *sch
 	return nil
 }
 
-func deleteGroup(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &aws.DeleteGroupInput{
 		GroupID: spotinst.String(groupId),
@@ -113,7 +117,7 @@ func deleteGroup(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Group delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
 	}
 	return nil
@@ -122,13 +126,26 @@ func deleteGroup(resourceData *schema.ResourceData, meta interface{}) error {
 // ErrCodeGroupNotFound for service response error code "GROUP_DOESNT_EXIST".
 const ErrCodeGroupNotFound = "GROUP_DOESNT_EXIST"
 
+func listElastigroupAWSNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().List(ctx, &aws.ListGroupsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %s", err)
+	}
+
+	groups := make([]namedResource, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, namedResource{id: spotinst.StringValue(group.ID), name: spotinst.StringValue(group.Name)})
+	}
+	return groups, nil
+}
+
 func resourceSpotinstElastigroupAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
 		commons.ElastigroupResource.GetName(), id)
 
 	input := &aws.ReadGroupInput{GroupID: spotinst.String(id)}
-	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().Read(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().Read(ctx, input)
 	if err != nil {
 		// If the group was not found, return nil so that we can show
 		// that the group does not exist
@@ -170,7 +187,7 @@ func resourceSpotinstElastigroupAWSCreate(ctx context.Context, resourceData *sch
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createGroup(resourceData, elastigroup, meta.(*Client))
+	groupId, err := createGroup(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -183,7 +200,7 @@ func resourceSpotinstElastigroupAWSCreate(ctx context.Context, resourceData *sch
 			return diag.Errorf("[ERROR] Your target healthy capacity must be less than or equal to your desired capcity")
 		}
 		if timeout, ok := resourceData.GetOkExists(string(elastigroup_aws.WaitForCapacityTimeout)); ok {
-			err := awaitReady(groupId, timeout.(int), capacity.(int), meta.(*Client))
+			err := awaitReady(ctx, groupId, timeout.(int), capacity.(int), meta.(*Client))
 			if err != nil {
 				return diag.Errorf("[ERROR] Timed out when creating group: %s", err)
 			}
@@ -195,7 +212,7 @@ func resourceSpotinstElastigroupAWSCreate(ctx context.Context, resourceData *sch
 	return resourceSpotinstElastigroupAWSRead(ctx, resourceData, meta)
 }
 
-func createGroup(resourceData *schema.ResourceData, group *aws.Group, spotinstClient *Client) (*string, error) {
+func createGroup(ctx context.Context, resourceData *schema.ResourceData, group *aws.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(group); err != nil {
 		return nil, err
 	} else {
@@ -204,13 +221,15 @@ func createGroup(resourceData *schema.ResourceData, group *aws.Group, spotinstCl
 
 	if v, ok := resourceData.Get(string(elastigroup_aws_launch_configuration.IamInstanceProfile)).(string); ok && v != "" {
 		// Wait for IAM instance profile to be ready.
-		time.Sleep(10 * time.Second)
+		if err := sleepContext(ctx, 10*time.Second); err != nil {
+			return nil, err
+		}
 	}
 
 	var resp *aws.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateGroupInput{Group: group}
-		r, err := spotinstClient.elastigroup.CloudProviderAWS().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderAWS().Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the group creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -255,7 +274,7 @@ func resourceSpotinstElastigroupAWSUpdate(ctx context.Context, resourceData *sch
 
 	if shouldUpdate {
 		elastigroup.SetId(spotinst.String(id))
-		if err := updateGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -264,7 +283,7 @@ func resourceSpotinstElastigroupAWSUpdate(ctx context.Context, resourceData *sch
 	return resourceSpotinstElastigroupAWSRead(ctx, resourceData, meta)
 }
 
-func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGroup(ctx context.Context, elastigroup *aws.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &aws.UpdateGroupInput{
 		Group: elastigroup,
 	}
@@ -299,7 +318,6 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 			return err
 		}
 
-		ctx := context.TODO()
 		svc := meta.(*Client).elastigroup.CloudProviderAWS()
 
 		for _, action := range actionList {
@@ -334,10 +352,10 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
 	} else if shouldRoll {
-		if err := rollGroup(resourceData, meta); err != nil {
+		if err := rollGroup(ctx, resourceData, meta); err != nil {
 			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
 			return err
 		}
@@ -350,7 +368,7 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 				}
 
 				if timeout, ok := resourceData.GetOkExists(string(elastigroup_aws.WaitForCapacityTimeout)); ok {
-					err := awaitReady(spotinst.String(groupId), timeout.(int), capacity.(int), meta.(*Client))
+					err := awaitReady(ctx, spotinst.String(groupId), timeout.(int), capacity.(int), meta.(*Client))
 					if err != nil {
 						return fmt.Errorf("[ERROR] Timed out when updating group: %s", err)
 					}
@@ -438,8 +456,7 @@ func deallocateStatefulInstance(ctx context.Context, svc aws.Service, groupID, i
 	return nil
 }
 
-func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+func rollGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupID := resourceData.Id()
 
 	updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_aws.UpdatePolicy))
@@ -469,9 +486,9 @@ func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupID, json)
 
-	retryTimeout := spotinst.IntValue(getRollTimeout(rollConfig))
+	retryTimeout := time.Duration(spotinst.IntValue(getRollTimeout(rollConfig))) * time.Second
 	if retryTimeout == 0 {
-		retryTimeout = 300
+		retryTimeout = resourceData.Timeout(schema.TimeoutUpdate)
 	}
 
 	var rollECS bool
@@ -496,7 +513,9 @@ func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
 				for _, err := range errs {
 					if strings.Contains(err.Code, "CANT_ROLL_CAPACITY_BELOW_MINIMUM") {
-						time.Sleep(time.Minute)
+						if err := sleepContext(ctx, time.Minute); err != nil {
+							return resource.NonRetryableError(err)
+						}
 						return resource.RetryableError(err)
 					}
 				}
@@ -517,7 +536,7 @@ func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	return resource.RetryContext(context.Background(), time.Duration(retryTimeout)*time.Second, retryFn)
+	return resource.RetryContext(ctx, retryTimeout, retryFn)
 }
 
 func convertToECSRollInput(rollGroupInput *aws.RollGroupInput) *aws.RollECSGroupInput {
@@ -528,15 +547,15 @@ func convertToECSRollInput(rollGroupInput *aws.RollGroupInput) *aws.RollECSGroup
 	return r
 }
 
-func awaitReady(groupId *string, timeout int, capacity int, client *Client) error {
+func awaitReady(ctx context.Context, groupId *string, timeout int, capacity int, client *Client) error {
 	if capacity == 0 || timeout == 0 {
 		return nil
 	}
 
-	err := resource.RetryContext(context.Background(), time.Second*time.Duration(timeout), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
 		input := &aws.GetInstanceHealthinessInput{GroupID: spotinst.String(*groupId)}
 		numHealthy := 0
-		status, err := client.elastigroup.CloudProviderAWS().GetInstanceHealthiness(context.Background(), input)
+		status, err := client.elastigroup.CloudProviderAWS().GetInstanceHealthiness(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitReady() -> getInstanceHealthiness [%v] API call failed, error: %v", groupId, err))
 		}
@@ -579,7 +598,7 @@ func awaitReadyRoll(ctx context.Context, groupID string, rollConfig interface{},
 	}
 
 	svc := client.elastigroup.CloudProviderAWS()
-	err := resource.RetryContext(context.Background(), time.Second*time.Duration(pctTimeout), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Second*time.Duration(pctTimeout), func() *resource.RetryError {
 		var rollStatus *aws.RollGroupOutput
 		var rollErr error
 
@@ -767,15 +786,23 @@ func updateCapitalSlice(resourceData *schema.ResourceData, groupResponse *aws.Gr
 		ebsBlockDevicesResourceData := resourceData.Get(string(elastigroup_aws_block_devices.EbsBlockDevice))
 		ebsBlockDevicesInput := ebsBlockDevicesResourceData.(*schema.Set).List()
 
-		for index, blockDeviceInput := range ebsBlockDevicesInput {
+		for _, blockDeviceInput := range ebsBlockDevicesInput {
 			blockDevice := blockDeviceInput.(map[string]interface{})
 
 			if volumeTypeInput, ok := blockDevice[string(elastigroup_aws_block_devices.VolumeType)].(string); ok && volumeTypeInput != "" {
 				if isUpper(volumeTypeInput) == false {
-					volumeTypeAPIResponse := blockDeviceMappingsAPIResponse[index].EBS.VolumeType
-					if volumeTypeAPIResponse != nil {
-						*volumeTypeAPIResponse = strings.ToLower(*volumeTypeAPIResponse)
-						blockDeviceMappingsAPIResponse[index].EBS.SetVolumeType(volumeTypeAPIResponse)
+					// The mappings also hold the ephemeral block devices, so
+					// they are matched by device name rather than by index.
+					deviceName, _ := blockDevice[string(elastigroup_aws_block_devices.DeviceName)].(string)
+					for _, blockDeviceMapping := range blockDeviceMappingsAPIResponse {
+						if blockDeviceMapping.EBS == nil || spotinst.StringValue(blockDeviceMapping.DeviceName) != deviceName {
+							continue
+						}
+						volumeTypeAPIResponse := blockDeviceMapping.EBS.VolumeType
+						if volumeTypeAPIResponse != nil {
+							*volumeTypeAPIResponse = strings.ToLower(*volumeTypeAPIResponse)
+							blockDeviceMapping.EBS.SetVolumeType(volumeTypeAPIResponse)
+						}
 					}
 				}
 			}
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +29,8 @@ func resourceSpotinstElastigroupAWSBeanstalk() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.ElastigroupAWSBeanstalkResource.GetTimeouts(),
+
 		Schema: commons.ElastigroupAWSBeanstalkResource.GetSchemaMap(),
 	}
 }
@@ -43,7 +44,7 @@ func setupElastigroupAWSBeanstalk() {
 	commons.ElastigroupAWSBeanstalkResource = commons.NewElastigroupAWSBeanstalkResource(fieldsMap)
 }
 
-func importBeanstalkGroup(resourceData *schema.ResourceData, meta interface{}) (*aws.Group, error) {
+func importBeanstalkGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) (*aws.Group, error) {
 	var input *aws.ImportBeanstalkInput
 
 	if environmentId, ok := resourceData.GetOk("beanstalk_environment_id"); ok {
@@ -57,7 +58,7 @@ func importBeanstalkGroup(resourceData *schema.ResourceData, meta interface{}) (
 			Region:          spotinst.String(resourceData.Get("region").(string))}
 	}
 
-	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().ImportBeanstalkEnv(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().ImportBeanstalkEnv(ctx, input)
 
 	if err != nil {
 		// If the group was not found, return nil so that we can show
@@ -77,18 +78,18 @@ func importBeanstalkGroup(resourceData *schema.ResourceData, meta interface{}) (
 	return resp.Group, err
 }
 
-func toggleMaintenanceMode(resourceData *schema.ResourceData, meta interface{}, op string) diag.Diagnostics {
+func toggleMaintenanceMode(ctx context.Context, resourceData *schema.ResourceData, meta interface{}, op string) diag.Diagnostics {
 	id := resourceData.Id()
 
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		input := &aws.BeanstalkMaintenanceInput{GroupID: spotinst.String(id)}
-		if status, err := meta.(*Client).elastigroup.CloudProviderAWS().GetBeanstalkMaintenanceStatus(context.Background(), input); err == nil {
+		if status, err := meta.(*Client).elastigroup.CloudProviderAWS().GetBeanstalkMaintenanceStatus(ctx, input); err == nil {
 			if op == "START" {
 				if *status == "AWAIT_USER_UPDATE" {
 					err = fmt.Errorf("===> Unable to start maintenance, already in maintenance mode")
 					return resource.NonRetryableError(err)
 				} else if *status == "ACTIVE" {
-					_, err := meta.(*Client).elastigroup.CloudProviderAWS().StartBeanstalkMaintenance(context.Background(), input)
+					_, err := meta.(*Client).elastigroup.CloudProviderAWS().StartBeanstalkMaintenance(ctx, input)
 					if err != nil {
 						return resource.NonRetryableError(err)
 					}
@@ -103,7 +104,7 @@ func toggleMaintenanceMode(resourceData *schema.ResourceData, meta interface{},
 					err = fmt.Errorf("===> Unable to end maintenance, your beanstalk elastigroup is already active")
 					return resource.NonRetryableError(err)
 				} else if *status == "AWAIT_USER_UPDATE" {
-					_, err := meta.(*Client).elastigroup.CloudProviderAWS().FinishBeanstalkMaintenance(context.Background(), input)
+					_, err := meta.(*Client).elastigroup.CloudProviderAWS().FinishBeanstalkMaintenance(ctx, input)
 					if err != nil {
 						return resource.NonRetryableError(err)
 					}
@@ -131,7 +132,7 @@ func resourceSpotinstAWSBeanstalkGroupCreate(ctx context.Context, resourceData *
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.ElastigroupAWSBeanstalkResource.GetName())
 
-	beanstalkGroup, err := importBeanstalkGroup(resourceData, meta.(*Client))
+	beanstalkGroup, err := importBeanstalkGroup(ctx, resourceData, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -145,7 +146,7 @@ func resourceSpotinstAWSBeanstalkGroupCreate(ctx context.Context, resourceData *
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createBeanstalkGroup(tempGroup, meta.(*Client))
+	groupId, err := createBeanstalkGroup(ctx, resourceData, tempGroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -155,7 +156,7 @@ func resourceSpotinstAWSBeanstalkGroupCreate(ctx context.Context, resourceData *
 	return resourceSpotinstAWSBeanstalkGroupRead(ctx, resourceData, meta)
 }
 
-func createBeanstalkGroup(beanstalkGroup *aws.Group, spotinstClient *Client) (*string, error) {
+func createBeanstalkGroup(ctx context.Context, resourceData *schema.ResourceData, beanstalkGroup *aws.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(beanstalkGroup); err != nil {
 		return nil, err
 	} else {
@@ -163,9 +164,9 @@ func createBeanstalkGroup(beanstalkGroup *aws.Group, spotinstClient *Client) (*s
 	}
 
 	var resp *aws.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateGroupInput{Group: beanstalkGroup}
-		r, err := spotinstClient.elastigroup.CloudProviderAWS().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderAWS().Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the group creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -195,7 +196,7 @@ func resourceSpotinstAWSBeanstalkGroupRead(ctx context.Context, resourceData *sc
 	log.Printf(string(commons.ResourceOnRead), commons.ElastigroupAWSBeanstalkResource.GetName(), id)
 
 	input := &aws.ReadGroupInput{GroupID: spotinst.String(id)}
-	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().Read(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().Read(ctx, input)
 	if err != nil {
 		// If the group was not found, return nil so that we can show
 		// that the group is gone.
@@ -242,13 +243,13 @@ func resourceSpotinstAWSBeanstalkGroupUpdate(ctx context.Context, resourceData *
 		return diag.FromErr(err)
 	}
 
-	maintErr := toggleMaintenanceMode(resourceData, meta, maint)
+	maintErr := toggleMaintenanceMode(ctx, resourceData, meta, maint)
 	if maintErr != nil {
 		return maintErr
 	}
 	if shouldUpdate {
 		elastigroupBeanstalk.SetId(spotinst.String(id))
-		if err := updateGroup(elastigroupBeanstalk, resourceData, meta); err != nil {
+		if err := updateGroup(ctx, elastigroupBeanstalk, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -261,7 +262,7 @@ func resourceSpotinstAWSBeanstalkGroupDelete(ctx context.Context, d *schema.Reso
 	log.Printf("[INFO] Deleting group: %s", d.Id())
 	input := &aws.DeleteGroupInput{GroupID: spotinst.String(d.Id())}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Delete(ctx, input); err != nil {
 		return diag.Errorf("failed to delete group: %s", err)
 	}
 	d.SetId("")
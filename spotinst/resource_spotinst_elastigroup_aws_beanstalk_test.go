@@ -3,7 +3,6 @@ package spotinst
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
@@ -39,7 +38,7 @@ func testSweepElastigroupBeanstalk(region string) error {
 			log.Printf("[INFO] No groups to sweep")
 		}
 		for _, group := range resp.Groups {
-			if strings.Contains(spotinst.StringValue(group.Name), "test-acc-") {
+			if isSweepable(group.Name) {
 				if _, err := conn.Delete(context.Background(), &aws.DeleteGroupInput{GroupID: group.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(group.ID))
 				} else {
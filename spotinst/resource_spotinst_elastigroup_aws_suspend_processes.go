@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,6 +28,8 @@ func resourceSpotinstElastigroupSuspendProcesses() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.SuspendProcessesResource.GetTimeouts(),
+
 		Schema: commons.SuspendProcessesResource.GetSchemaMap(),
 	}
 }
@@ -54,7 +55,7 @@ func resourceSpotinstAWSSuspendProcessesRead(ctx context.Context, resourceData *
 	input := &aws.ListSuspensionsInput{}
 	gID := resourceData.Id()
 	input.GroupID = &gID
-	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().ListSuspensions(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().ListSuspensions(ctx, input)
 	if err != nil {
 		// If the group was not found, return nil so that we can show
 		// that the group is gone.
@@ -94,7 +95,7 @@ func resourceSpotinstAWSSuspendProcessesCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	suspendProcessesId, err := createSuspendProcesses(resourceData, suspendProcesses, meta.(*Client))
+	suspendProcessesId, err := createSuspendProcesses(ctx, resourceData, suspendProcesses, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -107,19 +108,19 @@ func resourceSpotinstAWSSuspendProcessesCreate(ctx context.Context, resourceData
 
 }
 
-func createSuspendProcesses(resourceData *schema.ResourceData, suspendProcesses *aws.SuspendProcesses, spotinstClient *Client) (*string, error) {
+func createSuspendProcesses(ctx context.Context, resourceData *schema.ResourceData, suspendProcesses *aws.SuspendProcesses, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(suspendProcesses); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> SuspendProcesses create configuration: %s", json)
 	}
 	groupID := spotinst.String(resourceData.Get(string(elastigroup_aws_suspend_processes.GroupID)).(string))
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateSuspensionsInput{
 			GroupID:     groupID,
 			Suspensions: suspendProcesses.Suspensions,
 		}
-		_, err := spotinstClient.elastigroup.CloudProviderAWS().CreateSuspensions(context.Background(), input)
+		_, err := spotinstClient.elastigroup.CloudProviderAWS().CreateSuspensions(ctx, input)
 		if err != nil {
 			// an error occurred, no retryable errors for this resource.
 			return resource.NonRetryableError(err)
@@ -137,7 +138,7 @@ func resourceSpotinstAWSSuspendProcessesDelete(ctx context.Context, resourceData
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.SuspendProcessesResource.GetName(), resourceId)
 
-	if err := deleteSuspendProcesses(resourceData, meta); err != nil {
+	if err := deleteSuspendProcesses(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -146,13 +147,13 @@ func resourceSpotinstAWSSuspendProcessesDelete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteSuspendProcesses(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteSuspendProcesses(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 
 	listInput := &aws.ListSuspensionsInput{}
 	gID := resourceData.Id()
 	listInput.GroupID = &gID
 
-	curr, err := meta.(*Client).elastigroup.CloudProviderAWS().ListSuspensions(context.Background(), listInput)
+	curr, err := meta.(*Client).elastigroup.CloudProviderAWS().ListSuspensions(ctx, listInput)
 	if err != nil {
 		return fmt.Errorf("[ERROR] failed to update suspend processes: %v", err)
 	}
@@ -167,7 +168,7 @@ func deleteSuspendProcesses(resourceData *schema.ResourceData, meta interface{})
 		log.Printf("===> suspendProcesses delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().DeleteSuspensions(context.Background(), delInput); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().DeleteSuspensions(ctx, delInput); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete suspendProcesses for Elastigroup: %s", err)
 	}
 	return nil
@@ -182,7 +183,7 @@ func resourceSpotinstAWSSuspendProcessesUpdate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 	if shouldUpdate {
-		if err := updateSuspendProcesses(suspendProcesses, resourceData, meta); err != nil {
+		if err := updateSuspendProcesses(ctx, suspendProcesses, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -190,7 +191,7 @@ func resourceSpotinstAWSSuspendProcessesUpdate(ctx context.Context, resourceData
 	return resourceSpotinstAWSSuspendProcessesRead(ctx, resourceData, meta)
 }
 
-func updateSuspendProcesses(suspendProcesses *aws.SuspendProcesses, resourceData *schema.ResourceData, meta interface{}) error {
+func updateSuspendProcesses(ctx context.Context, suspendProcesses *aws.SuspendProcesses, resourceData *schema.ResourceData, meta interface{}) error {
 
 	var input = &aws.SuspendProcesses{
 		Suspensions: suspendProcesses.Suspensions,
@@ -209,7 +210,7 @@ func updateSuspendProcesses(suspendProcesses *aws.SuspendProcesses, resourceData
 	gID := resourceData.Id()
 	req.GroupID = &gID
 
-	curr, err := meta.(*Client).elastigroup.CloudProviderAWS().ListSuspensions(context.Background(), req)
+	curr, err := meta.(*Client).elastigroup.CloudProviderAWS().ListSuspensions(ctx, req)
 	if err != nil {
 		return fmt.Errorf("[ERROR] failed to update suspend processes: %v", err)
 	}
@@ -241,7 +242,7 @@ func updateSuspendProcesses(suspendProcesses *aws.SuspendProcesses, resourceData
 		groupIDInput := resourceData.Id()
 		createReqBody.GroupID = &groupIDInput
 
-		_, err = meta.(*Client).elastigroup.CloudProviderAWS().CreateSuspensions(context.Background(), createReqBody)
+		_, err = meta.(*Client).elastigroup.CloudProviderAWS().CreateSuspensions(ctx, createReqBody)
 		if err != nil {
 			return fmt.Errorf("[ERROR] failed to update suspend processes: %v", err)
 		}
@@ -253,7 +254,7 @@ func updateSuspendProcesses(suspendProcesses *aws.SuspendProcesses, resourceData
 			Processes: processesToDelete,
 		}
 
-		if _, err := meta.(*Client).elastigroup.CloudProviderAWS().DeleteSuspensions(context.Background(), deleteReqBody); err != nil {
+		if _, err := meta.(*Client).elastigroup.CloudProviderAWS().DeleteSuspensions(ctx, deleteReqBody); err != nil {
 			return fmt.Errorf("[ERROR] onDelete() -> Failed to delete suspendProcesses for Elastigroup: %s", err)
 		}
 	}
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -18,8 +18,9 @@ import (
 
 func init() {
 	resource.AddTestSweepers("spotinst_elastigroup_aws", &resource.Sweeper{
-		Name: "spotinst_elastigroup_aws",
-		F:    testSweepElastigroupAWS,
+		Name:         "spotinst_elastigroup_aws",
+		F:            testSweepElastigroupAWS,
+		Dependencies: []string{"spotinst_health_check", "spotinst_subscription"},
 	})
 }
 
@@ -38,7 +39,7 @@ func testSweepElastigroupAWS(region string) error {
 			log.Printf("[INFO] No groups to sweep")
 		}
 		for _, group := range resp.Groups {
-			if strings.Contains(spotinst.StringValue(group.Name), "test-acc-") {
+			if isSweepable(group.Name) {
 				if _, err := conn.Delete(context.Background(), &aws.DeleteGroupInput{GroupID: group.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(group.ID))
 				} else {
@@ -4557,3 +4558,34 @@ const testAwaitCapacity_EmptyFields = `
 `
 
 // endregion
+
+// region Elastigroup: Block Devices Volume Type
+
+func TestUpdateCapitalSlice_EphemeralBlockDevices(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupAWS().Schema, map[string]interface{}{
+		"ebs_block_device": []interface{}{
+			map[string]interface{}{"device_name": "/dev/xvdb", "volume_type": "gp2"},
+		},
+		"ephemeral_block_device": []interface{}{
+			map[string]interface{}{"device_name": "/dev/xvdc", "virtual_name": "ephemeral0"},
+		},
+	})
+
+	// The API returns the ephemeral block devices in the same mappings as
+	// the EBS ones, here before them.
+	ebs := &aws.BlockDeviceMapping{DeviceName: spotinst.String("/dev/xvdb"), EBS: &aws.EBS{VolumeType: spotinst.String("GP2")}}
+	group := &aws.Group{Compute: &aws.Compute{LaunchSpecification: &aws.LaunchSpecification{
+		BlockDeviceMappings: []*aws.BlockDeviceMapping{
+			{DeviceName: spotinst.String("/dev/xvdc"), VirtualName: spotinst.String("ephemeral0")},
+			ebs,
+		},
+	}}}
+
+	updateCapitalSlice(resourceData, group)
+
+	if got := spotinst.StringValue(ebs.EBS.VolumeType); got != "gp2" {
+		t.Fatalf("expected the volume type of %s to match the configured case, got %q", "/dev/xvdb", got)
+	}
+}
+
+// endregion
@@ -41,6 +41,10 @@ func resourceSpotinstElastigroupAzure() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.ElastigroupAzureResource.GetTimeouts(),
+
+		CustomizeDiff: commons.ElastigroupAzureResource.OnDiff,
+
 		Schema: commons.ElastigroupAzureResource.GetSchemaMap(),
 	}
 }
@@ -73,7 +77,7 @@ func resourceSpotinstElastigroupAzureCreate(ctx context.Context, resourceData *s
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createAzureGroup(elastigroup, meta.(*Client))
+	groupId, err := createAzureGroup(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,7 +89,7 @@ func resourceSpotinstElastigroupAzureCreate(ctx context.Context, resourceData *s
 	return resourceSpotinstElastigroupAzureRead(ctx, resourceData, meta)
 }
 
-func createAzureGroup(group *azure.Group, spotinstClient *Client) (*string, error) {
+func createAzureGroup(ctx context.Context, resourceData *schema.ResourceData, group *azure.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(group); err != nil {
 		return nil, err
 	} else {
@@ -93,9 +97,9 @@ func createAzureGroup(group *azure.Group, spotinstClient *Client) (*string, erro
 	}
 
 	var resp *azure.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &azure.CreateGroupInput{Group: group}
-		r, err := spotinstClient.elastigroup.CloudProviderAzure().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderAzure().Create(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -117,7 +121,7 @@ func resourceSpotinstElastigroupAzureRead(ctx context.Context, resourceData *sch
 		commons.ElastigroupAzureResource.GetName(), id)
 
 	input := &azure.ReadGroupInput{GroupID: spotinst.String(id)}
-	resp, err := meta.(*Client).elastigroup.CloudProviderAzure().Read(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderAzure().Read(ctx, input)
 	if err != nil {
 		// If the group was not found, return nil so that we can show
 		// that the group does not exist
@@ -160,7 +164,7 @@ func resourceSpotinstElastigroupAzureUpdate(ctx context.Context, resourceData *s
 
 	if shouldUpdate {
 		elastigroup.SetId(spotinst.String(id))
-		if err := updateAzureGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateAzureGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -169,7 +173,7 @@ func resourceSpotinstElastigroupAzureUpdate(ctx context.Context, resourceData *s
 	return resourceSpotinstElastigroupAzureRead(ctx, resourceData, meta)
 }
 
-func updateAzureGroup(elastigroup *azure.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateAzureGroup(ctx context.Context, elastigroup *azure.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &azure.UpdateGroupInput{
 		Group: elastigroup,
 	}
@@ -193,10 +197,10 @@ func updateAzureGroup(elastigroup *azure.Group, resourceData *schema.ResourceDat
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAzure().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAzure().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
 	} else if shouldRoll {
-		if err := rollAzureGroup(resourceData, meta); err != nil {
+		if err := rollAzureGroup(ctx, resourceData, meta); err != nil {
 			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
 			return err
 		}
@@ -206,7 +210,7 @@ func updateAzureGroup(elastigroup *azure.Group, resourceData *schema.ResourceDat
 	return nil
 }
 
-func rollAzureGroup(resourceData *schema.ResourceData, meta interface{}) error {
+func rollAzureGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	var errResult error = nil
 	groupId := resourceData.Id()
 
@@ -224,15 +228,17 @@ func rollAzureGroup(resourceData *schema.ResourceData, meta interface{}) error {
 						return err
 					} else {
 						log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupId, json)
-						errResult = resource.RetryContext(context.Background(), time.Minute*5, func() *resource.RetryError {
+						errResult = resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 							rollGroupInput.GroupID = spotinst.String(groupId)
-							_, err := meta.(*Client).elastigroup.CloudProviderAzure().Roll(context.Background(), rollGroupInput)
+							_, err := meta.(*Client).elastigroup.CloudProviderAzure().Roll(ctx, rollGroupInput)
 							if err != nil {
 								// checks whether to retry role
 								if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
 									for _, err := range errs {
 										if strings.Contains(err.Code, "CANT_ROLL_CAPACITY_BELOW_MINIMUM") {
-											time.Sleep(time.Minute)
+											if err := sleepContext(ctx, time.Minute); err != nil {
+												return resource.NonRetryableError(err)
+											}
 											return resource.RetryableError(err)
 										}
 									}
@@ -259,7 +265,7 @@ func resourceSpotinstElastigroupAzureDelete(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupAzureResource.GetName(), id)
 
-	if err := deleteAzureGroup(resourceData, meta); err != nil {
+	if err := deleteAzureGroup(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -268,7 +274,7 @@ func resourceSpotinstElastigroupAzureDelete(ctx context.Context, resourceData *s
 	return nil
 }
 
-func deleteAzureGroup(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteAzureGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &azure.DeleteGroupInput{
 		GroupID: spotinst.String(groupId),
@@ -280,7 +286,7 @@ func deleteAzureGroup(resourceData *schema.ResourceData, meta interface{}) error
 		log.Printf("===> Group delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAzure().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAzure().Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
 	}
 	return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -36,7 +35,7 @@ func testSweepElastigroupAzure(region string) error {
 			log.Printf("[INFO] No groups to sweep")
 		}
 		for _, group := range resp.Groups {
-			if strings.Contains(spotinst.StringValue(group.Name), "test-acc-") {
+			if isSweepable(group.Name) {
 				if _, err := conn.Delete(context.Background(), &azure.DeleteGroupInput{GroupID: group.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(group.ID))
 				} else {
@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_image"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_launchspecification"
@@ -32,9 +33,13 @@ func resourceSpotinstElastigroupAzureV3() *schema.Resource {
 		DeleteContext: resourceSpotinstElastigroupAzureV3Delete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByNameStateContext("Elastigroup", listElastigroupAzureV3Names),
 		},
 
+		Timeouts: commons.ElastigroupAzureV3Resource.GetTimeouts(),
+
+		CustomizeDiff: commons.ElastigroupAzureV3Resource.OnDiff,
+
 		Schema: commons.ElastigroupAzureV3Resource.GetSchemaMap(),
 	}
 }
@@ -62,7 +67,7 @@ func resourceSpotinstElastigroupAzureV3Create(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createAzureV3Group(elastigroup, meta.(*Client))
+	groupId, err := createAzureV3Group(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -74,7 +79,7 @@ func resourceSpotinstElastigroupAzureV3Create(ctx context.Context, resourceData
 	return resourceSpotinstElastigroupAzureV3Read(ctx, resourceData, meta)
 }
 
-func createAzureV3Group(group *v3.Group, spotinstClient *Client) (*string, error) {
+func createAzureV3Group(ctx context.Context, resourceData *schema.ResourceData, group *v3.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(group); err != nil {
 		return nil, err
 	} else {
@@ -82,9 +87,9 @@ func createAzureV3Group(group *v3.Group, spotinstClient *Client) (*string, error
 	}
 
 	var resp *v3.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &v3.CreateGroupInput{Group: group}
-		r, err := spotinstClient.elastigroup.CloudProviderAzureV3().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderAzureV3().Create(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -100,13 +105,26 @@ func createAzureV3Group(group *v3.Group, spotinstClient *Client) (*string, error
 	return resp.Group.ID, nil
 }
 
+func listElastigroupAzureV3Names(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).elastigroup.CloudProviderAzureV3().List(ctx, &v3.ListGroupsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %s", err)
+	}
+
+	groups := make([]namedResource, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, namedResource{id: spotinst.StringValue(group.ID), name: spotinst.StringValue(group.Name)})
+	}
+	return groups, nil
+}
+
 func resourceSpotinstElastigroupAzureV3Read(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceFieldOnRead),
 		commons.ElastigroupAzureV3Resource.GetName(), id)
 
 	input := &v3.ReadGroupInput{GroupID: spotinst.String(id)}
-	resp, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Read(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Read(ctx, input)
 	if err != nil {
 		// If the group was not found, return nil so that we can show
 		// that the group does not exist
@@ -149,7 +167,7 @@ func resourceSpotinstElastigroupAzureV3Update(ctx context.Context, resourceData
 
 	if shouldUpdate {
 		elastigroup.SetId(spotinst.String(id))
-		if err := updateAzureV3Group(elastigroup, resourceData, meta); err != nil {
+		if err := updateAzureV3Group(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -158,31 +176,125 @@ func resourceSpotinstElastigroupAzureV3Update(ctx context.Context, resourceData
 	return resourceSpotinstElastigroupAzureV3Read(ctx, resourceData, meta)
 }
 
-func updateAzureV3Group(elastigroup *v3.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateAzureV3Group(ctx context.Context, elastigroup *v3.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &v3.UpdateGroupInput{
 		Group: elastigroup,
 	}
 
+	var shouldRoll = false
 	groupId := resourceData.Id()
 
+	if updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_azure.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			if roll, ok := m[string(elastigroup_azure.ShouldRoll)].(bool); ok && roll {
+				shouldRoll = roll
+			}
+		}
+	}
+
 	if json, err := commons.ToJson(elastigroup); err != nil {
 		return err
 	} else {
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
+	} else if shouldRoll {
+		if err := rollAzureV3Group(ctx, resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_azure.ShouldRoll))
 	}
 	return nil
 }
 
+// azureV3RollGroupInput is the roll configuration of a group. The v3 groups
+// have no health check, so there is no grace period or health check type to
+// roll with, unlike the v2 ones.
+type azureV3RollGroupInput struct {
+	BatchSizePercentage       *int `json:"batchSizePercentage,omitempty"`
+	BatchMinHealthyPercentage *int `json:"batchMinHealthyPercentage,omitempty"`
+	DrainingTimeout           *int `json:"drainingTimeout,omitempty"`
+}
+
+// rollAzureV3Group starts a roll of the group using update_policy.roll_config.
+// The SDK does not cover the roll endpoint of the v3 API yet, so the request
+// is sent through the raw API client.
+func rollAzureV3Group(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	groupId := resourceData.Id()
+
+	list := resourceData.Get(string(elastigroup_azure.UpdatePolicy)).([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Missing update policy for group [%v]", groupId)
+	}
+
+	m := list[0].(map[string]interface{})
+	rollConfig, ok := m[string(elastigroup_azure.RollConfig)].([]interface{})
+	if !ok || len(rollConfig) == 0 || rollConfig[0] == nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Field [%v] is missing, skipping roll for group [%v]",
+			string(elastigroup_azure.RollConfig), groupId)
+	}
+
+	input := expandAzureV3GroupRollConfig(rollConfig[0].(map[string]interface{}))
+	if json, err := commons.ToJson(input); err != nil {
+		return err
+	} else {
+		log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupId, json)
+	}
+
+	path, err := uritemplates.Expand("/azure/compute/group/{groupId}/roll", uritemplates.Values{
+		"groupId": groupId,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		r := client.NewRequest(http.MethodPost, path)
+		r.Obj = input
+
+		if err := doAPIRequest(ctx, r, meta.(*Client)); err != nil {
+			return retryRollRequest(ctx, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Roll failed for group [%v], error: %v", groupId, err)
+	}
+
+	log.Printf("onRoll() -> Successfully rolled group [%v]", groupId)
+	return nil
+}
+
+func expandAzureV3GroupRollConfig(m map[string]interface{}) *azureV3RollGroupInput {
+	input := &azureV3RollGroupInput{}
+
+	if v, ok := m[string(elastigroup_azure.BatchSizePercentage)].(int); ok { // Required value
+		input.BatchSizePercentage = spotinst.Int(v)
+	}
+
+	if v, ok := m[string(elastigroup_azure.BatchMinHealthyPercentage)].(int); ok && v > 0 {
+		input.BatchMinHealthyPercentage = spotinst.Int(v)
+	}
+
+	if v, ok := m[string(elastigroup_azure.DrainingTimeout)].(int); ok && v > 0 {
+		input.DrainingTimeout = spotinst.Int(v)
+	}
+
+	return input
+}
+
 func resourceSpotinstElastigroupAzureV3Delete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupAzureV3Resource.GetName(), id)
 
-	if err := deleteAzureV3Group(resourceData, meta); err != nil {
+	if err := deleteAzureV3Group(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -191,7 +303,7 @@ func resourceSpotinstElastigroupAzureV3Delete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteAzureV3Group(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteAzureV3Group(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &v3.DeleteGroupInput{
 		GroupID: spotinst.String(groupId),
@@ -203,7 +315,7 @@ func deleteAzureV3Group(resourceData *schema.ResourceData, meta interface{}) err
 		log.Printf("===> Group delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
 	}
 	return nil
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	azurev3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -37,7 +38,7 @@ func testSweepElastigroupAzureV3(region string) error {
 			log.Printf("[INFO] No groups to sweep")
 		}
 		for _, group := range resp.Groups {
-			if strings.Contains(spotinst.StringValue(group.Name), "test-acc-") {
+			if isSweepable(group.Name) {
 				if _, err := conn.Delete(context.Background(), &azurev3.DeleteGroupInput{GroupID: group.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(group.ID))
 				} else {
@@ -588,3 +589,120 @@ const testAzureV3LoginGroupConfig_Create = `
 `
 
 // endregion
+
+// region Azure Elastigroup: Update Policy
+func TestAccSpotinstElastigroupAzureV3_UpdatePolicy(t *testing.T) {
+	groupName := "test-acc-eg-azure-v3-update-policy"
+	resourceName := createElastigroupAzureV3ResourceName(groupName)
+
+	var group azurev3.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "azure") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAzureDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAzureV3Terraform(&AzureV3GroupConfigMetadata{
+					groupName:      groupName,
+					fieldsToAppend: testAzureV3UpdatePolicyGroupConfig_Create,
+				}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAzureV3Exists(&group, resourceName),
+					testCheckElastigroupAzureV3Attributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "false"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "33"),
+				),
+			},
+			{
+				Config: createElastigroupAzureV3Terraform(&AzureV3GroupConfigMetadata{
+					groupName:            groupName,
+					updateBaselineFields: true,
+					fieldsToAppend:       testAzureV3UpdatePolicyGroupConfig_Update,
+				}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAzureV3Exists(&group, resourceName),
+					testCheckElastigroupAzureV3Attributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.draining_timeout", "120"),
+				),
+			},
+		},
+	})
+}
+
+const testAzureV3UpdatePolicyGroupConfig_Create = `
+// --- UPDATE POLICY ----------------------------
+  update_policy {
+    should_roll = false
+
+    roll_config {
+      batch_size_percentage = 33
+    }
+  }
+// ---------------------------------------------
+`
+
+const testAzureV3UpdatePolicyGroupConfig_Update = `
+// --- UPDATE POLICY ----------------------------
+  update_policy {
+    should_roll = true
+
+    roll_config {
+      batch_size_percentage        = 50
+      batch_min_healthy_percentage = 50
+      draining_timeout             = 120
+    }
+  }
+// ---------------------------------------------
+`
+
+// endregion
+
+func TestUnitSpotinstElastigroupAzureV3_Roll(t *testing.T) {
+	api := newFakeAPI(t)
+	r := Provider().ResourcesMap[string(commons.ElastigroupAzureV3ResourceName)]
+
+	api.objects["/azure/compute/group"] = map[string]map[string]interface{}{
+		"sig-12345678": {"id": "sig-12345678"},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"update_policy": []interface{}{map[string]interface{}{
+			"should_roll": true,
+			"roll_config": []interface{}{map[string]interface{}{
+				"batch_size_percentage":        50,
+				"batch_min_healthy_percentage": 80,
+				"draining_timeout":             120,
+			}},
+		}},
+	})
+	resourceData.SetId("sig-12345678")
+	if err := rollAzureV3Group(context.Background(), resourceData, api.Client()); err != nil {
+		t.Fatalf("roll failed: %v", err)
+	}
+
+	// Elastigroup rolls send the roll configuration as is, not wrapped in a
+	// roll object like the Ocean ones.
+	actions := api.Actions()
+	if len(actions) != 1 {
+		t.Fatalf("expected a single roll request, got %v", actions)
+	}
+	if got, expected := actions[0].String(), "POST /azure/compute/group/sig-12345678/roll"; got != expected {
+		t.Fatalf("expected the roll to be started with %q, got %q", expected, got)
+	}
+	expectedBody := map[string]interface{}{
+		"batchSizePercentage":       50.0,
+		"batchMinHealthyPercentage": 80.0,
+		"drainingTimeout":           120.0,
+	}
+	if !reflect.DeepEqual(actions[0].Body, expectedBody) {
+		t.Fatalf("expected the roll body %v, got %v", expectedBody, actions[0].Body)
+	}
+}
@@ -2,9 +2,12 @@ package spotinst
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,6 +15,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_disk"
@@ -23,6 +27,7 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_scheduled_task"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_strategy"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_update_policy"
 )
 
 func resourceSpotinstElastigroupGCP() *schema.Resource {
@@ -35,9 +40,13 @@ func resourceSpotinstElastigroupGCP() *schema.Resource {
 		DeleteContext: resourceSpotinstElastigroupGCPDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByNameStateContext("Elastigroup", listElastigroupGCPNames),
 		},
 
+		Timeouts: commons.ElastigroupGCPResource.GetTimeouts(),
+
+		CustomizeDiff: commons.ElastigroupGCPResource.OnDiff,
+
 		Schema: commons.ElastigroupGCPResource.GetSchemaMap(),
 	}
 }
@@ -56,6 +65,7 @@ func setupElastigroupGCPResource() {
 	elastigroup_gcp_scaling_policies.Setup(fieldsMap)
 	elastigroup_gcp_scheduled_task.Setup(fieldsMap)
 	elastigroup_gcp_strategy.Setup(fieldsMap)
+	elastigroup_gcp_update_policy.Setup(fieldsMap)
 
 	commons.ElastigroupGCPResource = commons.NewElastigroupGCPResource(fieldsMap)
 }
@@ -71,7 +81,7 @@ func resourceSpotinstElastigroupGCPCreate(ctx context.Context, resourceData *sch
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createGCPGroup(elastigroup, meta.(*Client))
+	groupId, err := createGCPGroup(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,17 +93,17 @@ func resourceSpotinstElastigroupGCPCreate(ctx context.Context, resourceData *sch
 
 // createGCPGroup makes the create request to the spotinst API and returns
 // the group ID of created group or an error if the request fails. It will retry
-// the request (default 1 min) when encountering a retryable error.
-func createGCPGroup(elastigroup *gcp.Group, spotinstClient *Client) (*string, error) {
+// the request until the create timeout expires when encountering a retryable error.
+func createGCPGroup(ctx context.Context, resourceData *schema.ResourceData, elastigroup *gcp.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(elastigroup); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> Group create configuration: %s", json)
 	}
 	var resp *gcp.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateGroupInput{Group: elastigroup}
-		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -106,6 +116,19 @@ func createGCPGroup(elastigroup *gcp.Group, spotinstClient *Client) (*string, er
 	return resp.Group.ID, nil
 }
 
+func listElastigroupGCPNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().List(ctx, &gcp.ListGroupsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %s", err)
+	}
+
+	groups := make([]namedResource, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, namedResource{id: spotinst.StringValue(group.ID), name: spotinst.StringValue(group.Name)})
+	}
+	return groups, nil
+}
+
 // resourceSpotinstElastigroupGCPRead creates an object representing an existing elastigroup
 // by making a get request using the Spotinst API or returns an error.
 func resourceSpotinstElastigroupGCPRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -114,7 +137,7 @@ func resourceSpotinstElastigroupGCPRead(ctx context.Context, resourceData *schem
 		commons.ElastigroupGCPResource.GetName(), groupId)
 
 	input := &gcp.ReadGroupInput{GroupID: spotinst.String(groupId)}
-	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().Read(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().Read(ctx, input)
 	if err != nil {
 		// if the group was not found, return nil to show the group doesn't exist
 		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -164,7 +187,7 @@ func resourceSpotinstElastigroupGCPUpdate(ctx context.Context, resourceData *sch
 
 	if shouldUpdate {
 		elastigroup.SetID(spotinst.String(groupId))
-		if err := updateGCPGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateGCPGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -174,7 +197,7 @@ func resourceSpotinstElastigroupGCPUpdate(ctx context.Context, resourceData *sch
 }
 
 // updateGCPGroup sends the update request to the Spotinst API and returns an error if the request fails.
-func updateGCPGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGCPGroup(ctx context.Context, elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &gcp.UpdateGroupInput{Group: elastigroup}
 	groupId := resourceData.Id()
 
@@ -184,10 +207,202 @@ func updateGCPGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, m
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
+	} else if shouldRollGCPGroup(resourceData) {
+		if err := rollGCPGroup(ctx, resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_gcp_update_policy.ShouldRoll))
+	}
+
+	return nil
+}
+
+// gcpRollGroupInput is the body of a GCP group roll request. The SDK does
+// not support rolling GCP groups, so the request is sent with the raw client.
+type gcpRollGroupInput struct {
+	BatchSizePercentage *int    `json:"batchSizePercentage,omitempty"`
+	GracePeriod         *int    `json:"gracePeriod,omitempty"`
+	HealthCheckType     *string `json:"healthCheckType,omitempty"`
+}
+
+type gcpRollGroupStatus struct {
+	ID       *string `json:"id,omitempty"`
+	Status   *string `json:"status,omitempty"`
+	Progress *struct {
+		Unit  *string  `json:"unit,omitempty"`
+		Value *float64 `json:"value,omitempty"`
+	} `json:"progress,omitempty"`
+}
+
+// shouldRollGCPGroup reports whether update_policy.should_roll is enabled.
+// It is shared by spotinst_elastigroup_gcp and spotinst_elastigroup_gke.
+func shouldRollGCPGroup(resourceData *schema.ResourceData) bool {
+	if updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_gcp_update_policy.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			if roll, ok := m[string(elastigroup_gcp_update_policy.ShouldRoll)].(bool); ok {
+				return roll
+			}
+		}
+	}
+	return false
+}
+
+// rollGCPGroup starts a roll of the group using update_policy.roll_config and,
+// when wait_for_roll_percentage is set, waits until enough of it completed.
+func rollGCPGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	groupID := resourceData.Id()
+
+	list := resourceData.Get(string(elastigroup_gcp_update_policy.UpdatePolicy)).([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+	rollConfig, ok := m[string(elastigroup_gcp_update_policy.RollConfig)].([]interface{})
+	if !ok || len(rollConfig) == 0 || rollConfig[0] == nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Field [%v] is missing, skipping roll for group [%v]",
+			string(elastigroup_gcp_update_policy.RollConfig), groupID)
+	}
+	rollSpec := rollConfig[0].(map[string]interface{})
+
+	input := expandGCPGroupRollConfig(rollSpec)
+	if json, err := commons.ToJson(input); err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Failed marshaling roll configuration for group [%v], error: %v", groupID, err)
+	} else {
+		log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupID, json)
+	}
+
+	status, err := startGCPGroupRoll(ctx, groupID, input, meta.(*Client))
+	if err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Roll failed for group [%v], error: %v", groupID, err)
+	}
+
+	pctComplete, _ := rollSpec[string(elastigroup_gcp_update_policy.WaitForRollPct)].(float64)
+	if pctComplete <= 0 {
+		log.Printf("onRoll() -> Successfully started roll of group [%v]", groupID)
+		return nil
+	}
+
+	timeout := resourceData.Timeout(schema.TimeoutUpdate)
+	if v, ok := rollSpec[string(elastigroup_gcp_update_policy.WaitForRollTimeout)].(int); ok && v > 0 {
+		timeout = time.Duration(v) * time.Second
+	}
+
+	rollID := spotinst.StringValue(status.ID)
+	if err := awaitGCPGroupRoll(ctx, groupID, rollID, pctComplete, timeout, meta.(*Client)); err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Roll [%v] of group [%v] did not complete: %v", rollID, groupID, err)
+	}
+
+	log.Printf("onRoll() -> Successfully rolled group [%v]", groupID)
+	return nil
+}
+
+func expandGCPGroupRollConfig(m map[string]interface{}) *gcpRollGroupInput {
+	input := &gcpRollGroupInput{}
+
+	if v, ok := m[string(elastigroup_gcp_update_policy.BatchSizePercentage)].(int); ok { // Required value
+		input.BatchSizePercentage = spotinst.Int(v)
+	}
+
+	if v, ok := m[string(elastigroup_gcp_update_policy.GracePeriod)].(int); ok && v != -1 { // Default value set to -1
+		input.GracePeriod = spotinst.Int(v)
+	}
+
+	if v, ok := m[string(elastigroup_gcp_update_policy.HealthCheckType)].(string); ok && v != "" { // Default value ""
+		input.HealthCheckType = spotinst.String(v)
+	}
+
+	return input
+}
+
+func startGCPGroupRoll(ctx context.Context, groupID string, input *gcpRollGroupInput, spotinstClient *Client) (*gcpRollGroupStatus, error) {
+	path, err := uritemplates.Expand("/gcp/gce/group/{groupId}/roll", uritemplates.Values{
+		"groupId": groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := client.NewRequest(http.MethodPut, path)
+	r.Obj = input
+
+	return doGCPGroupRollRequest(ctx, r, spotinstClient)
+}
+
+func readGCPGroupRoll(ctx context.Context, groupID, rollID string, spotinstClient *Client) (*gcpRollGroupStatus, error) {
+	path, err := uritemplates.Expand("/gcp/gce/group/{groupId}/roll/{rollId}", uritemplates.Values{
+		"groupId": groupID,
+		"rollId":  rollID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return doGCPGroupRollRequest(ctx, client.NewRequest(http.MethodGet, path), spotinstClient)
+}
+
+func doGCPGroupRollRequest(ctx context.Context, r *client.Request, spotinstClient *Client) (*gcpRollGroupStatus, error) {
+	resp, err := client.RequireOK(spotinstClient.api.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out client.Response
+	if err := client.DecodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Response.Items) == 0 {
+		return nil, fmt.Errorf("empty roll status response")
+	}
+
+	status := new(gcpRollGroupStatus)
+	if err := json.Unmarshal(out.Response.Items[0], status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func awaitGCPGroupRoll(ctx context.Context, groupID, rollID string, pctComplete float64, timeout time.Duration, spotinstClient *Client) error {
+	if rollID == "" {
+		return fmt.Errorf("invalid roll id: %s", rollID)
+	}
+
+	log.Printf("awaitGCPGroupRoll() Waiting for deployment of group: %s", groupID)
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		status, err := readGCPGroupRoll(ctx, groupID, rollID, spotinstClient)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("call to roll status of group %q failed: %v", groupID, err))
+		}
+
+		rollStatus := strings.ToUpper(spotinst.StringValue(status.Status))
+		if rollStatus == "FAILED" || rollStatus == "STOPPED" {
+			return resource.NonRetryableError(fmt.Errorf("roll %s", strings.ToLower(rollStatus)))
+		}
+
+		var progress float64
+		if status.Progress != nil {
+			progress = spotinst.Float64Value(status.Progress.Value)
+		}
+		if rollStatus != "COMPLETED" && progress < pctComplete {
+			log.Printf("awaitGCPGroupRoll() Waiting for at least %f%% of batches to complete, current status: %f%%",
+				pctComplete, progress)
+			return resource.RetryableError(fmt.Errorf("roll at %v%% complete", progress))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	log.Printf("awaitGCPGroupRoll() Target deployment percentage reached for group: %s", groupID)
 	return nil
 }
 
@@ -197,7 +412,7 @@ func resourceSpotinstElastigroupGCPDelete(ctx context.Context, resourceData *sch
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupGCPResource.GetName(), groupId)
 
-	if err := deleteGCPGroup(resourceData, meta); err != nil {
+	if err := deleteGCPGroup(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -207,7 +422,7 @@ func resourceSpotinstElastigroupGCPDelete(ctx context.Context, resourceData *sch
 }
 
 // deleteGCPGroup sends the delete request to the Spotinst API or an error if the request fails.
-func deleteGCPGroup(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGCPGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &gcp.DeleteGroupInput{GroupID: spotinst.String(groupId)}
 
@@ -217,7 +432,7 @@ func deleteGCPGroup(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Group delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
 	}
 	return nil
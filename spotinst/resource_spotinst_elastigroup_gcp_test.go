@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -37,7 +40,7 @@ func testSweepElastigroupGCP(region string) error {
 			log.Printf("[INFO] No groups to sweep")
 		}
 		for _, group := range resp.Groups {
-			if strings.Contains(spotinst.StringValue(group.Name), "test-acc-") {
+			if isSweepable(group.Name) {
 				if _, err := conn.Delete(context.Background(), &gcp.DeleteGroupInput{GroupID: group.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(group.ID))
 				} else {
@@ -1597,3 +1600,152 @@ const testGCPScheduledTaskGroupConfig_EmptyFields = `
 `
 
 // endregion
+
+// region Elastigroup GCP: Update Policy
+func TestAccSpotinstElastigroupGCP_UpdatePolicy(t *testing.T) {
+	groupName := "test-acc-eg-gcp-update-policy"
+	resourceName := createElastigroupGCPResourceName(groupName)
+
+	var group gcp.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t, "gcp") },
+		Providers:     TestAccProviders,
+		CheckDestroy:  testElastigroupGCPDestroy,
+		IDRefreshName: resourceName,
+
+		Steps: []resource.TestStep{
+			{
+				ResourceName: resourceName,
+				Config: createElastigroupGCPTerraform(&GCPGroupConfigMetadata{
+					groupName:      groupName,
+					fieldsToAppend: testUpdatePolicyGCPGroupConfig_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupGCPExists(&group, resourceName),
+					testCheckElastigroupGCPAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "false"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "33"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.health_check_type", "NONE"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config: createElastigroupGCPTerraform(&GCPGroupConfigMetadata{
+					groupName:      groupName,
+					instanceTypes:  testInstanceTypesGCPGroupConfig_Update,
+					fieldsToAppend: testUpdatePolicyGCPGroupConfig_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupGCPExists(&group, resourceName),
+					testCheckElastigroupGCPAttributes(&group, groupName),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_ondemand", "n1-standard-2"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "66"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.grace_period", "300"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.wait_for_roll_percentage", "100"),
+				),
+			},
+		},
+	})
+}
+
+const testUpdatePolicyGCPGroupConfig_Create = `
+ // --- UPDATE POLICY ----------------
+  update_policy {
+    should_roll = false
+
+    roll_config {
+      batch_size_percentage = 33
+      health_check_type     = "NONE"
+    }
+  }
+ // ----------------------------------
+`
+
+const testUpdatePolicyGCPGroupConfig_Update = `
+ // --- UPDATE POLICY ----------------
+  update_policy {
+    should_roll = true
+
+    roll_config {
+      batch_size_percentage    = 66
+      grace_period             = 300
+      health_check_type        = "NONE"
+      wait_for_roll_percentage = 100
+      wait_for_roll_timeout    = 1200
+    }
+  }
+ // ----------------------------------
+`
+
+// endregion
+
+func TestUnitSpotinstElastigroupGCP_RollWait(t *testing.T) {
+	cases := []struct {
+		name   string
+		status map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "completed below the wait percentage",
+			status: map[string]interface{}{"status": "COMPLETED", "progress": map[string]interface{}{"value": 50}},
+		},
+		{
+			name:   "failed",
+			status: map[string]interface{}{"status": "FAILED", "progress": map[string]interface{}{"value": 20}},
+			err:    "roll failed",
+		},
+		{
+			name: "status error",
+			err:  "GROUP_DOESNT_EXIST",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPut && r.URL.Path == "/gcp/gce/group/sig-12345678/roll":
+					writeFakeItems(w, []interface{}{map[string]interface{}{"id": "sbgd-12345678", "status": "IN_PROGRESS"}})
+				case r.Method == http.MethodGet && r.URL.Path == "/gcp/gce/group/sig-12345678/roll/sbgd-12345678" && c.status != nil:
+					writeFakeItems(w, []interface{}{c.status})
+				default:
+					writeFakeError(w, http.StatusBadRequest, "GROUP_DOESNT_EXIST", "group does not exist")
+				}
+			}))
+			defer server.Close()
+
+			config := Config{Token: "fake", Account: "act-fake", Endpoint: server.URL}
+			meta, diags := config.Client()
+			if diags.HasError() {
+				t.Fatalf("failed to create the client: %v", diags)
+			}
+
+			r := Provider().ResourcesMap[string(commons.ElastigroupGCPResourceName)]
+			resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"update_policy": []interface{}{map[string]interface{}{
+					"should_roll": true,
+					"roll_config": []interface{}{map[string]interface{}{
+						"batch_size_percentage":    50,
+						"wait_for_roll_percentage": 100,
+						"wait_for_roll_timeout":    5,
+					}},
+				}},
+			})
+			resourceData.SetId("sig-12345678")
+
+			err := rollGCPGroup(context.Background(), resourceData, meta)
+			if c.err == "" {
+				if err != nil {
+					t.Fatalf("expected the roll to complete, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.err) || strings.Contains(err.Error(), "Timed out") {
+				t.Fatalf("expected the roll to fail with %q, got %v", c.err, err)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +20,7 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_network_interface"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_strategy"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gcp_update_policy"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_gke"
 )
 
@@ -37,6 +37,10 @@ func resourceSpotinstElastigroupGKE() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.ElastigroupGKEResource.GetTimeouts(),
+
+		CustomizeDiff: commons.ElastigroupGKEResource.OnDiff,
+
 		Schema: commons.ElastigroupGKEResource.GetSchemaMap(),
 	}
 }
@@ -54,11 +58,12 @@ func setupElastigroupGKEResource() {
 	elastigroup_gcp_network_interface.Setup(fieldsMap)
 	elastigroup_gcp_scaling_policies.Setup(fieldsMap)
 	elastigroup_gcp_strategy.Setup(fieldsMap)
+	elastigroup_gcp_update_policy.Setup(fieldsMap)
 
 	commons.ElastigroupGKEResource = commons.NewElastigroupGKEResource(fieldsMap)
 }
 
-func importGKEGroup(resourceData *schema.ResourceData, meta interface{}) (*gcp.Group, error) {
+func importGKEGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) (*gcp.Group, error) {
 	// first build a GCP group from the user's template
 	templateGroup, err := commons.ElastigroupGKEResource.OnCreate(resourceData, meta)
 	if err != nil {
@@ -98,7 +103,7 @@ func importGKEGroup(resourceData *schema.ResourceData, meta interface{}) (*gcp.G
 	}
 
 	// make te request with the custom group, get back a GCP group with some generated fields
-	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().ImportGKECluster(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().ImportGKECluster(ctx, input)
 
 	if err != nil {
 		// If the group was not found, return nil so that we can show
@@ -125,7 +130,7 @@ func resourceSpotinstElastigroupGKECreate(ctx context.Context, resourceData *sch
 		commons.ElastigroupGKEResource.GetName())
 
 	// do the import call and get the generated fields
-	gkeGroup, err := importGKEGroup(resourceData, meta.(*Client))
+	gkeGroup, err := importGKEGroup(ctx, resourceData, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -147,7 +152,7 @@ func resourceSpotinstElastigroupGKECreate(ctx context.Context, resourceData *sch
 	}
 
 	// call create with the reconciled group
-	groupId, err := createGKEGroup(tempGroup, meta.(*Client))
+	groupId, err := createGKEGroup(ctx, resourceData, tempGroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -157,7 +162,7 @@ func resourceSpotinstElastigroupGKECreate(ctx context.Context, resourceData *sch
 	return resourceSpotinstElastigroupGKERead(ctx, resourceData, meta)
 }
 
-func createGKEGroup(gkeGroup *gcp.Group, spotinstClient *Client) (*string, error) {
+func createGKEGroup(ctx context.Context, resourceData *schema.ResourceData, gkeGroup *gcp.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(gkeGroup); err != nil {
 		return nil, err
 	} else {
@@ -165,9 +170,9 @@ func createGKEGroup(gkeGroup *gcp.Group, spotinstClient *Client) (*string, error
 	}
 
 	var resp *gcp.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateGroupInput{Group: gkeGroup}
-		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(ctx, input)
 		if err != nil {
 
 			// If there's some other error, report it.
@@ -191,7 +196,7 @@ func resourceSpotinstElastigroupGKERead(ctx context.Context, resourceData *schem
 		commons.ElastigroupGKEResource.GetName(), groupId)
 
 	input := &gcp.ReadGroupInput{GroupID: spotinst.String(groupId)}
-	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().Read(context.Background(), input)
+	resp, err := meta.(*Client).elastigroup.CloudProviderGCP().Read(ctx, input)
 	if err != nil {
 		// if the group was not found, return nil to show the group doesn't exist
 		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -237,7 +242,7 @@ func resourceSpotinstElastigroupGKEUpdate(ctx context.Context, resourceData *sch
 	if shouldUpdate {
 		elastigroup.SetID(spotinst.String(groupId))
 
-		if err := updateGKEGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateGKEGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -247,7 +252,7 @@ func resourceSpotinstElastigroupGKEUpdate(ctx context.Context, resourceData *sch
 }
 
 // updateGKEGroup sends the update request to the Spotinst API and returns an error if the request fails.
-func updateGKEGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGKEGroup(ctx context.Context, elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	// we need to remove the location and clusterID params used when calling Create.
 	// The core does not support these when calling Update.
 	elastigroup.Integration.SetGKE(&gcp.GKEIntegration{
@@ -264,8 +269,15 @@ func updateGKEGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, m
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
+	} else if shouldRollGCPGroup(resourceData) {
+		if err := rollGCPGroup(ctx, resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_gcp_update_policy.ShouldRoll))
 	}
 
 	return nil
@@ -277,7 +289,7 @@ func resourceSpotinstElastigroupGKEDelete(ctx context.Context, resourceData *sch
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupGKEResource.GetName(), groupId)
 
-	if err := deleteGKEGroup(resourceData, meta); err != nil {
+	if err := deleteGKEGroup(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -287,7 +299,7 @@ func resourceSpotinstElastigroupGKEDelete(ctx context.Context, resourceData *sch
 }
 
 // deleteGKEGroup sends the delete request to the Spotinst API or an error if the request fails.
-func deleteGKEGroup(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGKEGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &gcp.DeleteGroupInput{GroupID: spotinst.String(groupId)}
 
@@ -297,7 +309,7 @@ func deleteGKEGroup(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Group delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
 	}
 	return nil
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +29,8 @@ func resourceSpotinstHealthCheck() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.HealthCheckResource.GetTimeouts(),
+
 		Schema: commons.HealthCheckResource.GetSchemaMap(),
 	}
 }
@@ -48,7 +49,7 @@ func resourceSpotinstHealthCheckRead(ctx context.Context, resourceData *schema.R
 	log.Printf(string(commons.ResourceOnRead), commons.HealthCheckResource.GetName(), resourceId)
 
 	input := &healthcheck.ReadHealthCheckInput{HealthCheckID: spotinst.String(resourceId)}
-	resp, err := meta.(*Client).healthCheck.Read(context.Background(), input)
+	resp, err := meta.(*Client).healthCheck.Read(ctx, input)
 	if err != nil {
 		// If the HealthCheck was not found, return nil so that we can show
 		// that the HealthCheck does not exist
@@ -86,7 +87,7 @@ func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema
 		return diag.FromErr(err)
 	}
 
-	healthCheckId, err := createHealthCheck(resourceData, healthCheck, meta.(*Client))
+	healthCheckId, err := createHealthCheck(ctx, resourceData, healthCheck, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,16 +100,16 @@ func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema
 
 }
 
-func createHealthCheck(resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck, spotinstClient *Client) (*string, error) {
+func createHealthCheck(ctx context.Context, resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(healthCheck); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> HealthCheck create configuration: %s", json)
 	}
 	var resp *healthcheck.CreateHealthCheckOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &healthcheck.CreateHealthCheckInput{HealthCheck: healthCheck}
-		r, err := spotinstClient.healthCheck.Create(context.Background(), input)
+		r, err := spotinstClient.healthCheck.Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the HealthCheck creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -143,7 +144,7 @@ func resourceSpotinstHealthCheckUpdate(ctx context.Context, resourceData *schema
 
 	if shouldUpdate {
 		healthCheck.SetId(spotinst.String(resourceId))
-		if err := updateHealthCheck(healthCheck, resourceData, meta); err != nil {
+		if err := updateHealthCheck(ctx, healthCheck, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -151,7 +152,7 @@ func resourceSpotinstHealthCheckUpdate(ctx context.Context, resourceData *schema
 	return resourceSpotinstHealthCheckRead(ctx, resourceData, meta)
 }
 
-func updateHealthCheck(healthCheck *healthcheck.HealthCheck, resourceData *schema.ResourceData, meta interface{}) error {
+func updateHealthCheck(ctx context.Context, healthCheck *healthcheck.HealthCheck, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &healthcheck.UpdateHealthCheckInput{
 		HealthCheck: healthCheck,
 	}
@@ -164,7 +165,7 @@ func updateHealthCheck(healthCheck *healthcheck.HealthCheck, resourceData *schem
 		log.Printf("===> HealthCheck update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).healthCheck.Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).healthCheck.Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update HealthCheck [%v]: %v", healthCheckId, err)
 	}
 	return nil
@@ -174,7 +175,7 @@ func resourceSpotinstHealthCheckDelete(ctx context.Context, resourceData *schema
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.HealthCheckResource.GetName(), resourceId)
 
-	if err := deleteHealthCheck(resourceData, meta); err != nil {
+	if err := deleteHealthCheck(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -183,7 +184,7 @@ func resourceSpotinstHealthCheckDelete(ctx context.Context, resourceData *schema
 	return nil
 }
 
-func deleteHealthCheck(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteHealthCheck(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	healthCheckId := resourceData.Id()
 	input := &healthcheck.DeleteHealthCheckInput{
 		HealthCheckID: spotinst.String(healthCheckId),
@@ -194,7 +195,7 @@ func deleteHealthCheck(resourceData *schema.ResourceData, meta interface{}) erro
 		log.Printf("===> HealthCheck delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).healthCheck.Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).healthCheck.Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete HealthCheck: %s", err)
 	}
 	return nil
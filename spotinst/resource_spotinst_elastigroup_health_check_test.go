@@ -13,6 +13,40 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_health_check", &resource.Sweeper{
+		Name: "spotinst_health_check",
+		F:    testSweepHealthCheck,
+	})
+}
+
+func testSweepHealthCheck(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).healthCheck
+	input := &healthcheck.ListHealthChecksInput{}
+	if resp, err := conn.List(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of health checks to sweep")
+	} else {
+		if len(resp.HealthChecks) == 0 {
+			log.Printf("[INFO] No health checks to sweep")
+		}
+		for _, check := range resp.HealthChecks {
+			if isSweepable(check.Name) {
+				if _, err := conn.Delete(context.Background(), &healthcheck.DeleteHealthCheckInput{HealthCheckID: check.ID}); err != nil {
+					return fmt.Errorf("unable to delete health check %v in sweep", spotinst.StringValue(check.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(check.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createHealthCheckResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.HealthCheckResourceName), name)
 }
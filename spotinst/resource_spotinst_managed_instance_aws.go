@@ -39,6 +39,10 @@ func resourceSpotinstMangedInstanceAWS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.ManagedInstanceResource.GetTimeouts(),
+
+		CustomizeDiff: commons.ManagedInstanceResource.OnDiff,
+
 		Schema: commons.ManagedInstanceResource.GetSchemaMap(),
 	}
 }
@@ -68,7 +72,7 @@ func resourceSpotinstManagedInstanceAWSRead(ctx context.Context, resourceData *s
 		commons.ManagedInstanceResource.GetName(), id)
 
 	input := &aws.ReadManagedInstanceInput{ManagedInstanceID: spotinst.String(id)}
-	resp, err := meta.(*Client).managedInstance.CloudProviderAWS().Read(context.Background(), input)
+	resp, err := meta.(*Client).managedInstance.CloudProviderAWS().Read(ctx, input)
 	if err != nil {
 		// If the group was not found, return nil so that we can show
 		// that the group does not exist
@@ -108,7 +112,7 @@ func resourceSpotinstManagedInstanceAWSCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	ManagedInstanceId, err := createManagedInstance(resourceData, mangedInstance, meta.(*Client))
+	ManagedInstanceId, err := createManagedInstance(ctx, resourceData, mangedInstance, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,20 +124,22 @@ func resourceSpotinstManagedInstanceAWSCreate(ctx context.Context, resourceData
 	return resourceSpotinstManagedInstanceAWSRead(ctx, resourceData, meta)
 }
 
-func createManagedInstance(resourceData *schema.ResourceData, mangedInstance *aws.ManagedInstance, spotinstClient *Client) (*string, error) {
+func createManagedInstance(ctx context.Context, resourceData *schema.ResourceData, mangedInstance *aws.ManagedInstance, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(mangedInstance); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> ManagedInstance create configuration: %s", json)
 	}
 	if v, ok := resourceData.Get(string(managed_instance_aws_compute_launchspecification.IAMInstanceProfile)).(string); ok && v != "" {
-		time.Sleep(5 * time.Second)
+		if err := sleepContext(ctx, 5*time.Second); err != nil {
+			return nil, err
+		}
 	}
 
 	var resp *aws.CreateManagedInstanceOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateManagedInstanceInput{ManagedInstance: mangedInstance}
-		r, err := spotinstClient.managedInstance.CloudProviderAWS().Create(context.Background(), input)
+		r, err := spotinstClient.managedInstance.CloudProviderAWS().Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the group creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -170,7 +176,7 @@ func resourceSpotinstManagedInstanceAWSUpdate(ctx context.Context, resourceData
 
 	if shouldUpdate {
 		managedInstance.SetId(spotinst.String(id))
-		if err := updateAWSManagedInstance(managedInstance, resourceData, meta); err != nil {
+		if err := updateAWSManagedInstance(ctx, managedInstance, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -179,7 +185,7 @@ func resourceSpotinstManagedInstanceAWSUpdate(ctx context.Context, resourceData
 	return resourceSpotinstManagedInstanceAWSRead(ctx, resourceData, meta)
 }
 
-func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData *schema.ResourceData, meta interface{}) error {
+func updateAWSManagedInstance(ctx context.Context, managedInstance *aws.ManagedInstance, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &aws.UpdateManagedInstanceInput{
 		ManagedInstance: managedInstance,
 	}
@@ -187,7 +193,6 @@ func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData
 	if instanceActions, exists := resourceData.GetOk(string(managed_instance_aws.ManagedInstanceAction)); exists {
 		actionList := instanceActions.([]interface{})
 
-		ctx := context.TODO()
 		svc := meta.(*Client).managedInstance.CloudProviderAWS()
 
 		for _, action := range actionList {
@@ -219,7 +224,7 @@ func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData
 		log.Printf("===> ManagedInstance update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).managedInstance.CloudProviderAWS().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).managedInstance.CloudProviderAWS().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update managed instance [%v]: %v", resourceData.Id(), err)
 	}
 
@@ -276,7 +281,7 @@ func resourceSpotinstManagedInstanceAWSDelete(ctx context.Context, resourceData
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ManagedInstanceResource.GetName(), id)
 
-	if err := deleteManagedInstance(resourceData, meta); err != nil {
+	if err := deleteManagedInstance(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -285,7 +290,7 @@ func resourceSpotinstManagedInstanceAWSDelete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteManagedInstance(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteManagedInstance(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	managedInstanceId := resourceData.Id()
 	input := &aws.DeleteManagedInstanceInput{
 		ManagedInstanceID: spotinst.String(managedInstanceId),
@@ -305,7 +310,7 @@ func deleteManagedInstance(resourceData *schema.ResourceData, meta interface{})
 		log.Printf("===> ManagedInstance delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).managedInstance.CloudProviderAWS().Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).managedInstance.CloudProviderAWS().Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete ManagedInstance: %s", err)
 	}
 	return nil
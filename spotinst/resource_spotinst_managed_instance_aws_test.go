@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -38,7 +37,7 @@ func testSweepManagedInstance(region string) error {
 			log.Printf("[INFO] No groups to sweep")
 		}
 		for _, managedInstance := range resp.ManagedInstances {
-			if strings.Contains(spotinst.StringValue(managedInstance.Name), "test-acc-") {
+			if isSweepable(managedInstance.Name) {
 				if _, err := conn.Delete(context.Background(), &aws.DeleteManagedInstanceInput{ManagedInstanceID: managedInstance.ID}); err != nil {
 					return fmt.Errorf("unable to delete managedInstance %v in sweep", spotinst.StringValue(managedInstance.ID))
 				} else {
@@ -36,6 +36,8 @@ func resourceSpotinstMRScalerAWS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.MRScalerAWSResource.GetTimeouts(),
+
 		Schema: commons.MRScalerAWSResource.GetSchemaMap(),
 	}
 }
@@ -63,7 +65,7 @@ func resourceSpotinstMRScalerAWSCreate(ctx context.Context, resourceData *schema
 		return diag.FromErr(err)
 	}
 
-	scalerId, err := createScaler(scaler, meta.(*Client))
+	scalerId, err := createScaler(ctx, resourceData, scaler, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -75,7 +77,7 @@ func resourceSpotinstMRScalerAWSCreate(ctx context.Context, resourceData *schema
 	return resourceSpotinstMRScalerAWSRead(ctx, resourceData, meta)
 }
 
-func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, error) {
+func createScaler(ctx context.Context, resourceData *schema.ResourceData, scaler *mrscaler.Scaler, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(scaler); err != nil {
 		return nil, err
 	} else {
@@ -83,9 +85,9 @@ func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, err
 	}
 
 	var resp *mrscaler.CreateScalerOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &mrscaler.CreateScalerInput{Scaler: scaler}
-		r, err := spotinstClient.mrscaler.Create(context.Background(), input)
+		r, err := spotinstClient.mrscaler.Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the scaler creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -112,12 +114,14 @@ func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, err
 
 func resourceSpotinstMRScalerAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
-	time.Sleep(10 * time.Second)
+	if err := sleepContext(ctx, 10*time.Second); err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf(string(commons.ResourceOnRead),
 		commons.MRScalerAWSResource.GetName(), id)
 
 	input := &mrscaler.ReadScalerInput{ScalerID: spotinst.String(id)}
-	resp, err := meta.(*Client).mrscaler.Read(context.Background(), input)
+	resp, err := meta.(*Client).mrscaler.Read(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read mr scaler: %s", err)
 	}
@@ -130,7 +134,7 @@ func resourceSpotinstMRScalerAWSRead(ctx context.Context, resourceData *schema.R
 	}
 
 	if exist := resourceData.Get(string(mrscaler_aws.ExposeClusterID)).(bool); exist {
-		if err := exposeMrScalerClusterId(resourceData, meta); err != nil {
+		if err := exposeMrScalerClusterId(ctx, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -155,7 +159,7 @@ func resourceSpotinstMRScalerAWSUpdate(ctx context.Context, resourceData *schema
 
 	if shouldUpdate {
 		scaler.SetId(spotinst.String(id))
-		if err := updateScaler(scaler, resourceData, meta); err != nil {
+		if err := updateScaler(ctx, scaler, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -164,7 +168,7 @@ func resourceSpotinstMRScalerAWSUpdate(ctx context.Context, resourceData *schema
 	return resourceSpotinstMRScalerAWSRead(ctx, resourceData, meta)
 }
 
-func updateScaler(scaler *mrscaler.Scaler, resourceData *schema.ResourceData, meta interface{}) error {
+func updateScaler(ctx context.Context, scaler *mrscaler.Scaler, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &mrscaler.UpdateScalerInput{
 		Scaler: scaler,
 	}
@@ -177,7 +181,7 @@ func updateScaler(scaler *mrscaler.Scaler, resourceData *schema.ResourceData, me
 		log.Printf("===> Scaler update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).mrscaler.Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).mrscaler.Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", scalerId, err)
 	}
 	return nil
@@ -188,7 +192,7 @@ func resourceSpotinstMRScalerAWSDelete(ctx context.Context, resourceData *schema
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MRScalerAWSResource.GetName(), id)
 
-	if err := deleteScaler(resourceData, meta); err != nil {
+	if err := deleteScaler(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -197,7 +201,7 @@ func resourceSpotinstMRScalerAWSDelete(ctx context.Context, resourceData *schema
 	return nil
 }
 
-func deleteScaler(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteScaler(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	scalerId := resourceData.Id()
 	input := &mrscaler.DeleteScalerInput{
 		ScalerID: spotinst.String(scalerId),
@@ -209,16 +213,16 @@ func deleteScaler(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Scaler delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).mrscaler.Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).mrscaler.Delete(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete scaler: %s", err)
 	}
 	return nil
 }
 
-func exposeMrScalerClusterId(resourceData *schema.ResourceData, meta interface{}) error {
+func exposeMrScalerClusterId(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	spotinstClient := meta.(*Client)
 	input := &mrscaler.ScalerClusterStatusInput{ScalerID: spotinst.String(resourceData.Id())}
-	resp, err := spotinstClient.mrscaler.ReadScalerCluster(context.Background(), input)
+	resp, err := spotinstClient.mrscaler.ReadScalerCluster(ctx, input)
 
 	if err != nil {
 		return fmt.Errorf("failed reading cloned cluster id of mr scaler : %s", err)
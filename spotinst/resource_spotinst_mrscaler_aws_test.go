@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 	"testing"
 	"time"
 
@@ -38,7 +37,7 @@ func testSweepMRScalerAWS(region string) error {
 			log.Printf("[INFO] No scalers to sweep")
 		}
 		for _, scaler := range resp.Scalers {
-			if strings.Contains(spotinst.StringValue(scaler.Name), "test-acc-") {
+			if isSweepable(scaler.Name) {
 				if _, err := conn.Delete(context.Background(), &mrscaler.DeleteScalerInput{ScalerID: scaler.ID}); err != nil {
 					return fmt.Errorf("unable to delete scaler %v in sweep", spotinst.StringValue(scaler.ID))
 				} else {
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +27,8 @@ func resourceSpotinstMultaiBalancer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.MultaiBalancerResource.GetTimeouts(),
+
 		Schema: commons.MultaiBalancerResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +50,7 @@ func resourceSpotinstMultaiBalancerCreate(ctx context.Context, resourceData *sch
 		return diag.FromErr(err)
 	}
 
-	balancerId, err := createBalancer(balancer, meta.(*Client))
+	balancerId, err := createBalancer(ctx, resourceData, balancer, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +61,7 @@ func resourceSpotinstMultaiBalancerCreate(ctx context.Context, resourceData *sch
 	return resourceSpotinstMultaiBalancerRead(ctx, resourceData, meta)
 }
 
-func createBalancer(balancer *multai.LoadBalancer, spotinstClient *Client) (*string, error) {
+func createBalancer(ctx context.Context, resourceData *schema.ResourceData, balancer *multai.LoadBalancer, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(balancer); err != nil {
 		return nil, err
 	} else {
@@ -68,9 +69,9 @@ func createBalancer(balancer *multai.LoadBalancer, spotinstClient *Client) (*str
 	}
 
 	var resp *multai.CreateLoadBalancerOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &multai.CreateLoadBalancerInput{Balancer: balancer}
-		r, err := spotinstClient.multai.CreateLoadBalancer(context.Background(), input)
+		r, err := spotinstClient.multai.CreateLoadBalancer(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,7 +91,7 @@ func resourceSpotinstMultaiBalancerRead(ctx context.Context, resourceData *schem
 		commons.MultaiBalancerResource.GetName(), balancerId)
 
 	input := &multai.ReadLoadBalancerInput{BalancerID: spotinst.String(balancerId)}
-	resp, err := meta.(*Client).multai.ReadLoadBalancer(context.Background(), input)
+	resp, err := meta.(*Client).multai.ReadLoadBalancer(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read balancer: %s", err)
 	}
@@ -122,7 +123,7 @@ func resourceSpotinstMultaiBalancerUpdate(ctx context.Context, resourceData *sch
 
 	if shouldUpdate {
 		balancer.SetId(spotinst.String(balancerId))
-		if err := updateBalancer(balancer, resourceData, meta); err != nil {
+		if err := updateBalancer(ctx, balancer, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -131,7 +132,7 @@ func resourceSpotinstMultaiBalancerUpdate(ctx context.Context, resourceData *sch
 	return resourceSpotinstMultaiBalancerRead(ctx, resourceData, meta)
 }
 
-func updateBalancer(balancer *multai.LoadBalancer, resourceData *schema.ResourceData, meta interface{}) error {
+func updateBalancer(ctx context.Context, balancer *multai.LoadBalancer, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &multai.UpdateLoadBalancerInput{Balancer: balancer}
 	balancerId := resourceData.Id()
 
@@ -141,7 +142,7 @@ func updateBalancer(balancer *multai.LoadBalancer, resourceData *schema.Resource
 		log.Printf("===> Balancer update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.UpdateLoadBalancer(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.UpdateLoadBalancer(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update balancer [%v]: %v", balancerId, err)
 	}
 
@@ -153,7 +154,7 @@ func resourceSpotinstMultaiBalancerDelete(ctx context.Context, resourceData *sch
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MultaiBalancerResource.GetName(), balancerId)
 
-	if err := deleteBalancer(resourceData, meta); err != nil {
+	if err := deleteBalancer(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -162,7 +163,7 @@ func resourceSpotinstMultaiBalancerDelete(ctx context.Context, resourceData *sch
 	return nil
 }
 
-func deleteBalancer(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteBalancer(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	balancerId := resourceData.Id()
 	input := &multai.DeleteLoadBalancerInput{BalancerID: spotinst.String(balancerId)}
 
@@ -172,7 +173,7 @@ func deleteBalancer(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Balancer delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.DeleteLoadBalancer(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.DeleteLoadBalancer(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete balancer: %s", err)
 	}
 	return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,8 +15,9 @@ import (
 
 func init() {
 	resource.AddTestSweepers("spotinst_multai_balancer", &resource.Sweeper{
-		Name: "spotinst_multai_balancer",
-		F:    testSweepMultaiBalancer,
+		Name:         "spotinst_multai_balancer",
+		F:            testSweepMultaiBalancer,
+		Dependencies: []string{"spotinst_multai_listener", "spotinst_multai_routing_rule", "spotinst_multai_target_set"},
 	})
 }
 
@@ -36,7 +36,7 @@ func testSweepMultaiBalancer(region string) error {
 			log.Printf("[INFO] No balancers to sweep")
 		}
 		for _, bal := range resp.Balancers {
-			if strings.Contains(spotinst.StringValue(bal.Name), "test-acc-") {
+			if isSweepable(bal.Name) {
 				if _, err := conn.DeleteLoadBalancer(context.Background(), &multai.DeleteLoadBalancerInput{BalancerID: bal.ID}); err != nil {
 					return fmt.Errorf("unable to delete balancer %v in sweep", spotinst.StringValue(bal.ID))
 				} else {
@@ -48,6 +48,24 @@ func testSweepMultaiBalancer(region string) error {
 	return nil
 }
 
+// testSweepMultaiBalancerIDs returns the IDs of the balancers to sweep. The
+// listeners and routing rules have no name, they are swept with their
+// balancer.
+func testSweepMultaiBalancerIDs(conn multai.Service) (map[string]bool, error) {
+	resp, err := conn.ListLoadBalancers(context.Background(), &multai.ListLoadBalancersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting list of balancers to sweep")
+	}
+
+	ids := make(map[string]bool)
+	for _, bal := range resp.Balancers {
+		if isSweepable(bal.Name) {
+			ids[spotinst.StringValue(bal.ID)] = true
+		}
+	}
+	return ids, nil
+}
+
 func createMultaiBalancerResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.MultaiBalancerResourceName), name)
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +27,8 @@ func resourceSpotinstMultaiDeployment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.MultaiDeploymentResource.GetTimeouts(),
+
 		Schema: commons.MultaiDeploymentResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +50,7 @@ func resourceSpotinstMultaiDeploymentCreate(ctx context.Context, resourceData *s
 		return diag.FromErr(err)
 	}
 
-	deploymentId, err := createDeployment(deployment, meta.(*Client))
+	deploymentId, err := createDeployment(ctx, resourceData, deployment, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +61,7 @@ func resourceSpotinstMultaiDeploymentCreate(ctx context.Context, resourceData *s
 	return resourceSpotinstMultaiDeploymentRead(ctx, resourceData, meta)
 }
 
-func createDeployment(deployment *multai.Deployment, spotinstClient *Client) (*string, error) {
+func createDeployment(ctx context.Context, resourceData *schema.ResourceData, deployment *multai.Deployment, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(deployment); err != nil {
 		return nil, err
 	} else {
@@ -68,9 +69,9 @@ func createDeployment(deployment *multai.Deployment, spotinstClient *Client) (*s
 	}
 
 	var resp *multai.CreateDeploymentOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &multai.CreateDeploymentInput{Deployment: deployment}
-		r, err := spotinstClient.multai.CreateDeployment(context.Background(), input)
+		r, err := spotinstClient.multai.CreateDeployment(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,7 +91,7 @@ func resourceSpotinstMultaiDeploymentRead(ctx context.Context, resourceData *sch
 		commons.MultaiDeploymentResource.GetName(), deploymentId)
 
 	input := &multai.ReadDeploymentInput{DeploymentID: spotinst.String(deploymentId)}
-	resp, err := meta.(*Client).multai.ReadDeployment(context.Background(), input)
+	resp, err := meta.(*Client).multai.ReadDeployment(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read deployment: %s", err)
 	}
@@ -122,7 +123,7 @@ func resourceSpotinstMultaiDeploymentUpdate(ctx context.Context, resourceData *s
 
 	if shouldUpdate {
 		deployment.SetId(spotinst.String(deploymentId))
-		if err := updateDeployment(deployment, resourceData, meta); err != nil {
+		if err := updateDeployment(ctx, deployment, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -131,7 +132,7 @@ func resourceSpotinstMultaiDeploymentUpdate(ctx context.Context, resourceData *s
 	return resourceSpotinstMultaiDeploymentRead(ctx, resourceData, meta)
 }
 
-func updateDeployment(deployment *multai.Deployment, resourceData *schema.ResourceData, meta interface{}) error {
+func updateDeployment(ctx context.Context, deployment *multai.Deployment, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &multai.UpdateDeploymentInput{Deployment: deployment}
 	deploymentId := resourceData.Id()
 
@@ -141,7 +142,7 @@ func updateDeployment(deployment *multai.Deployment, resourceData *schema.Resour
 		log.Printf("===> Deployment update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.UpdateDeployment(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.UpdateDeployment(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update deployment [%v]: %v", deploymentId, err)
 	}
 
@@ -153,7 +154,7 @@ func resourceSpotinstMultaiDeploymentDelete(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MultaiDeploymentResource.GetName(), deploymentId)
 
-	if err := deleteDeployment(resourceData, meta); err != nil {
+	if err := deleteDeployment(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -162,7 +163,7 @@ func resourceSpotinstMultaiDeploymentDelete(ctx context.Context, resourceData *s
 	return nil
 }
 
-func deleteDeployment(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteDeployment(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	deploymentId := resourceData.Id()
 	input := &multai.DeleteDeploymentInput{DeploymentID: spotinst.String(deploymentId)}
 
@@ -172,7 +173,7 @@ func deleteDeployment(resourceData *schema.ResourceData, meta interface{}) error
 		log.Printf("===> Deployment delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.DeleteDeployment(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.DeleteDeployment(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete deployment: %s", err)
 	}
 	return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,8 +15,9 @@ import (
 
 func init() {
 	resource.AddTestSweepers("spotinst_multai_deployment", &resource.Sweeper{
-		Name: "spotinst_multai_deployment",
-		F:    testSweepMultaiDeployment,
+		Name:         "spotinst_multai_deployment",
+		F:            testSweepMultaiDeployment,
+		Dependencies: []string{"spotinst_multai_balancer", "spotinst_multai_target_set"},
 	})
 }
 
@@ -36,7 +36,7 @@ func testSweepMultaiDeployment(region string) error {
 			log.Printf("[INFO] No deployments to sweep")
 		}
 		for _, depl := range resp.Deployments {
-			if strings.Contains(spotinst.StringValue(depl.Name), "test-acc-") {
+			if isSweepable(depl.Name) {
 				if _, err := conn.DeleteDeployment(context.Background(), &multai.DeleteDeploymentInput{DeploymentID: depl.ID}); err != nil {
 					return fmt.Errorf("unable to delete deployment %v in sweep", spotinst.StringValue(depl.ID))
 				} else {
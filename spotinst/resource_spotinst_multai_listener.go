@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,9 +24,14 @@ func resourceSpotinstMultaiListener() *schema.Resource {
 		DeleteContext: resourceSpotinstMultaiListenerDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importChildStateContext(childImporter{
+				parentAttribute: string(multai_listener.BalancerID),
+				parentOf:        readMultaiListenerBalancerID,
+			}),
 		},
 
+		Timeouts: commons.MultaiListenerResource.GetTimeouts(),
+
 		Schema: commons.MultaiListenerResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +53,7 @@ func resourceSpotinstMultaiListenerCreate(ctx context.Context, resourceData *sch
 		return diag.FromErr(err)
 	}
 
-	listenerId, err := createListener(listener, meta.(*Client))
+	listenerId, err := createListener(ctx, resourceData, listener, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +64,7 @@ func resourceSpotinstMultaiListenerCreate(ctx context.Context, resourceData *sch
 	return resourceSpotinstMultaiListenerRead(ctx, resourceData, meta)
 }
 
-func createListener(listener *multai.Listener, spotinstClient *Client) (*string, error) {
+func createListener(ctx context.Context, resourceData *schema.ResourceData, listener *multai.Listener, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(listener); err != nil {
 		return nil, err
 	} else {
@@ -68,9 +72,9 @@ func createListener(listener *multai.Listener, spotinstClient *Client) (*string,
 	}
 
 	var resp *multai.CreateListenerOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &multai.CreateListenerInput{Listener: listener}
-		r, err := spotinstClient.multai.CreateListener(context.Background(), input)
+		r, err := spotinstClient.multai.CreateListener(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,7 +94,7 @@ func resourceSpotinstMultaiListenerRead(ctx context.Context, resourceData *schem
 		commons.MultaiListenerResource.GetName(), listenerId)
 
 	input := &multai.ReadListenerInput{ListenerID: spotinst.String(listenerId)}
-	resp, err := meta.(*Client).multai.ReadListener(context.Background(), input)
+	resp, err := meta.(*Client).multai.ReadListener(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read listener: %s", err)
 	}
@@ -110,6 +114,18 @@ func resourceSpotinstMultaiListenerRead(ctx context.Context, resourceData *schem
 	return nil
 }
 
+func readMultaiListenerBalancerID(ctx context.Context, listenerID string, meta interface{}) (string, error) {
+	input := &multai.ReadListenerInput{ListenerID: spotinst.String(listenerID)}
+	resp, err := meta.(*Client).multai.ReadListener(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to read listener: %s", err)
+	}
+	if resp.Listener == nil {
+		return "", fmt.Errorf("listener %q not found", listenerID)
+	}
+	return spotinst.StringValue(resp.Listener.BalancerID), nil
+}
+
 func resourceSpotinstMultaiListenerUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	listenerId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate),
@@ -122,7 +138,7 @@ func resourceSpotinstMultaiListenerUpdate(ctx context.Context, resourceData *sch
 
 	if shouldUpdate {
 		listener.SetId(spotinst.String(listenerId))
-		if err := updateListener(listener, resourceData, meta); err != nil {
+		if err := updateListener(ctx, listener, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -131,7 +147,7 @@ func resourceSpotinstMultaiListenerUpdate(ctx context.Context, resourceData *sch
 	return resourceSpotinstMultaiListenerRead(ctx, resourceData, meta)
 }
 
-func updateListener(listener *multai.Listener, resourceData *schema.ResourceData, meta interface{}) error {
+func updateListener(ctx context.Context, listener *multai.Listener, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &multai.UpdateListenerInput{Listener: listener}
 	listenerId := resourceData.Id()
 
@@ -141,7 +157,7 @@ func updateListener(listener *multai.Listener, resourceData *schema.ResourceData
 		log.Printf("===> Listener update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.UpdateListener(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.UpdateListener(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update listener [%v]: %v", listenerId, err)
 	}
 
@@ -153,7 +169,7 @@ func resourceSpotinstMultaiListenerDelete(ctx context.Context, resourceData *sch
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MultaiListenerResource.GetName(), listenerId)
 
-	if err := deleteListener(resourceData, meta); err != nil {
+	if err := deleteListener(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -162,7 +178,7 @@ func resourceSpotinstMultaiListenerDelete(ctx context.Context, resourceData *sch
 	return nil
 }
 
-func deleteListener(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteListener(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	listenerId := resourceData.Id()
 	input := &multai.DeleteListenerInput{ListenerID: spotinst.String(listenerId)}
 
@@ -172,7 +188,7 @@ func deleteListener(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Listener delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.DeleteListener(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.DeleteListener(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete listener: %s", err)
 	}
 	return nil
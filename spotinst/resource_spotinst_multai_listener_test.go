@@ -13,6 +13,46 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_multai_listener", &resource.Sweeper{
+		Name:         "spotinst_multai_listener",
+		F:            testSweepMultaiListener,
+		Dependencies: []string{"spotinst_multai_routing_rule"},
+	})
+}
+
+func testSweepMultaiListener(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).multai
+	balancerIDs, err := testSweepMultaiBalancerIDs(conn)
+	if err != nil {
+		return err
+	}
+
+	input := &multai.ListListenersInput{}
+	if resp, err := conn.ListListeners(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of listeners to sweep")
+	} else {
+		if len(resp.Listeners) == 0 {
+			log.Printf("[INFO] No listeners to sweep")
+		}
+		for _, listener := range resp.Listeners {
+			if balancerIDs[spotinst.StringValue(listener.BalancerID)] {
+				if _, err := conn.DeleteListener(context.Background(), &multai.DeleteListenerInput{ListenerID: listener.ID}); err != nil {
+					return fmt.Errorf("unable to delete listener %v in sweep", spotinst.StringValue(listener.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(listener.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createMultaiListenerResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.MultaiListenerResourceName), name)
 }
@@ -152,7 +192,7 @@ const (
 const testBaselineListenerConfig_Create = `
 resource "spotinst_multai_balancer" "foo" {
   provider = "aws"
-  name = "foo"
+  name = "test-acc-foo"
 
   connection_timeouts {
     idle     = 10
@@ -180,7 +220,7 @@ resource "` + string(commons.MultaiListenerResourceName) + `" "%v" {
 const testBaselineListenerConfig_Update = `
 resource "spotinst_multai_balancer" "foo" {
   provider = "aws"
-  name = "foo"
+  name = "test-acc-foo"
 
   connection_timeouts {
     idle     = 10
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +27,8 @@ func resourceSpotinstMultaiRoutingRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.MultaiRoutingRuleResource.GetTimeouts(),
+
 		Schema: commons.MultaiRoutingRuleResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +50,7 @@ func resourceSpotinstMultaiRoutingRuleCreate(ctx context.Context, resourceData *
 		return diag.FromErr(err)
 	}
 
-	routingRuleId, err := createRoutingRule(routingRule, meta.(*Client))
+	routingRuleId, err := createRoutingRule(ctx, resourceData, routingRule, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +61,7 @@ func resourceSpotinstMultaiRoutingRuleCreate(ctx context.Context, resourceData *
 	return resourceSpotinstMultaiRoutingRuleRead(ctx, resourceData, meta)
 }
 
-func createRoutingRule(routingRule *multai.RoutingRule, spotinstClient *Client) (*string, error) {
+func createRoutingRule(ctx context.Context, resourceData *schema.ResourceData, routingRule *multai.RoutingRule, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(routingRule); err != nil {
 		return nil, err
 	} else {
@@ -68,9 +69,9 @@ func createRoutingRule(routingRule *multai.RoutingRule, spotinstClient *Client)
 	}
 
 	var resp *multai.CreateRoutingRuleOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &multai.CreateRoutingRuleInput{RoutingRule: routingRule}
-		r, err := spotinstClient.multai.CreateRoutingRule(context.Background(), input)
+		r, err := spotinstClient.multai.CreateRoutingRule(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,7 +91,7 @@ func resourceSpotinstMultaiRoutingRuleRead(ctx context.Context, resourceData *sc
 		commons.MultaiRoutingRuleResource.GetName(), routingRuleId)
 
 	input := &multai.ReadRoutingRuleInput{RoutingRuleID: spotinst.String(routingRuleId)}
-	resp, err := meta.(*Client).multai.ReadRoutingRule(context.Background(), input)
+	resp, err := meta.(*Client).multai.ReadRoutingRule(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read routing rule: %s", err)
 	}
@@ -122,7 +123,7 @@ func resourceSpotinstMultaiRoutingRuleUpdate(ctx context.Context, resourceData *
 
 	if shouldUpdate {
 		routingRule.SetId(spotinst.String(routingRuleId))
-		if err := updateRoutingRule(routingRule, resourceData, meta); err != nil {
+		if err := updateRoutingRule(ctx, routingRule, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -131,7 +132,7 @@ func resourceSpotinstMultaiRoutingRuleUpdate(ctx context.Context, resourceData *
 	return resourceSpotinstMultaiRoutingRuleRead(ctx, resourceData, meta)
 }
 
-func updateRoutingRule(routingRule *multai.RoutingRule, resourceData *schema.ResourceData, meta interface{}) error {
+func updateRoutingRule(ctx context.Context, routingRule *multai.RoutingRule, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &multai.UpdateRoutingRuleInput{RoutingRule: routingRule}
 	routingRuleId := resourceData.Id()
 
@@ -141,7 +142,7 @@ func updateRoutingRule(routingRule *multai.RoutingRule, resourceData *schema.Res
 		log.Printf("===> Routing Rule update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.UpdateRoutingRule(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.UpdateRoutingRule(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update routing rule [%v]: %v", routingRuleId, err)
 	}
 
@@ -153,7 +154,7 @@ func resourceSpotinstMultaiRoutingRuleDelete(ctx context.Context, resourceData *
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MultaiRoutingRuleResource.GetName(), routingRuleId)
 
-	if err := deleteRoutingRule(resourceData, meta); err != nil {
+	if err := deleteRoutingRule(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -162,7 +163,7 @@ func resourceSpotinstMultaiRoutingRuleDelete(ctx context.Context, resourceData *
 	return nil
 }
 
-func deleteRoutingRule(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteRoutingRule(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	routingRuleId := resourceData.Id()
 	input := &multai.DeleteRoutingRuleInput{RoutingRuleID: spotinst.String(routingRuleId)}
 
@@ -172,7 +173,7 @@ func deleteRoutingRule(resourceData *schema.ResourceData, meta interface{}) erro
 		log.Printf("===> Routing Rule delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.DeleteRoutingRule(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.DeleteRoutingRule(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete routing rule: %s", err)
 	}
 	return nil
@@ -13,6 +13,45 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_multai_routing_rule", &resource.Sweeper{
+		Name: "spotinst_multai_routing_rule",
+		F:    testSweepMultaiRoutingRule,
+	})
+}
+
+func testSweepMultaiRoutingRule(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).multai
+	balancerIDs, err := testSweepMultaiBalancerIDs(conn)
+	if err != nil {
+		return err
+	}
+
+	input := &multai.ListRoutingRulesInput{}
+	if resp, err := conn.ListRoutingRules(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of routing rules to sweep")
+	} else {
+		if len(resp.RoutingRules) == 0 {
+			log.Printf("[INFO] No routing rules to sweep")
+		}
+		for _, rule := range resp.RoutingRules {
+			if balancerIDs[spotinst.StringValue(rule.BalancerID)] {
+				if _, err := conn.DeleteRoutingRule(context.Background(), &multai.DeleteRoutingRuleInput{RoutingRuleID: rule.ID}); err != nil {
+					return fmt.Errorf("unable to delete routing rule %v in sweep", spotinst.StringValue(rule.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(rule.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createMultaiRoutingRuleResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.MultaiRoutingRuleResourceName), name)
 }
@@ -25,9 +25,14 @@ func resourceSpotinstMultaiTarget() *schema.Resource {
 		DeleteContext: resourceSpotinstMultaiTargetDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importChildStateContext(childImporter{
+				parentAttribute: string(multai_target.TargetSetID),
+				parentOf:        readMultaiTargetTargetSetID,
+			}),
 		},
 
+		Timeouts: commons.MultaiTargetResource.GetTimeouts(),
+
 		Schema: commons.MultaiTargetResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +54,7 @@ func resourceSpotinstMultaiTargetCreate(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
-	targetId, err := createTarget(target, meta.(*Client))
+	targetId, err := createTarget(ctx, resourceData, target, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +65,7 @@ func resourceSpotinstMultaiTargetCreate(ctx context.Context, resourceData *schem
 	return resourceSpotinstMultaiTargetRead(ctx, resourceData, meta)
 }
 
-func createTarget(target *multai.Target, spotinstClient *Client) (*string, error) {
+func createTarget(ctx context.Context, resourceData *schema.ResourceData, target *multai.Target, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(target); err != nil {
 		return nil, err
 	} else {
@@ -68,9 +73,9 @@ func createTarget(target *multai.Target, spotinstClient *Client) (*string, error
 	}
 
 	var resp *multai.CreateTargetOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &multai.CreateTargetInput{Target: target}
-		r, err := spotinstClient.multai.CreateTarget(context.Background(), input)
+		r, err := spotinstClient.multai.CreateTarget(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,7 +95,7 @@ func resourceSpotinstMultaiTargetRead(ctx context.Context, resourceData *schema.
 		commons.MultaiTargetResource.GetName(), targetId)
 
 	input := &multai.ReadTargetInput{TargetID: spotinst.String(targetId)}
-	resp, err := meta.(*Client).multai.ReadTarget(context.Background(), input)
+	resp, err := meta.(*Client).multai.ReadTarget(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read target: %s", err)
 	}
@@ -110,6 +115,18 @@ func resourceSpotinstMultaiTargetRead(ctx context.Context, resourceData *schema.
 	return nil
 }
 
+func readMultaiTargetTargetSetID(ctx context.Context, targetID string, meta interface{}) (string, error) {
+	input := &multai.ReadTargetInput{TargetID: spotinst.String(targetID)}
+	resp, err := meta.(*Client).multai.ReadTarget(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to read target: %s", err)
+	}
+	if resp.Target == nil {
+		return "", fmt.Errorf("target %q not found", targetID)
+	}
+	return spotinst.StringValue(resp.Target.TargetSetID), nil
+}
+
 func resourceSpotinstMultaiTargetUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	targetId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate),
@@ -122,7 +139,7 @@ func resourceSpotinstMultaiTargetUpdate(ctx context.Context, resourceData *schem
 
 	if shouldUpdate {
 		target.SetId(spotinst.String(targetId))
-		if err := updateTarget(target, resourceData, meta); err != nil {
+		if err := updateTarget(ctx, target, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -131,7 +148,7 @@ func resourceSpotinstMultaiTargetUpdate(ctx context.Context, resourceData *schem
 	return resourceSpotinstMultaiTargetRead(ctx, resourceData, meta)
 }
 
-func updateTarget(target *multai.Target, resourceData *schema.ResourceData, meta interface{}) error {
+func updateTarget(ctx context.Context, target *multai.Target, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &multai.UpdateTargetInput{Target: target}
 	targetId := resourceData.Id()
 
@@ -141,7 +158,7 @@ func updateTarget(target *multai.Target, resourceData *schema.ResourceData, meta
 		log.Printf("===> Target update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.UpdateTarget(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.UpdateTarget(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update target [%v]: %v", targetId, err)
 	}
 
@@ -153,11 +170,11 @@ func resourceSpotinstMultaiTargetDelete(ctx context.Context, resourceData *schem
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MultaiTargetResource.GetName(), targetId)
 
-	if err := deleteTarget(resourceData, meta); err != nil {
+	if err := deleteTarget(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
-	err := awaitTargetDeleted(spotinst.String(targetId), meta.(*Client))
+	err := awaitTargetDeleted(ctx, spotinst.String(targetId), resourceData.Timeout(schema.TimeoutDelete), meta.(*Client))
 	if err != nil {
 		return diag.Errorf("[ERROR] Timed out when waiting for the target to delete. error: %v", err)
 	}
@@ -167,7 +184,7 @@ func resourceSpotinstMultaiTargetDelete(ctx context.Context, resourceData *schem
 	return nil
 }
 
-func deleteTarget(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteTarget(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	targetId := resourceData.Id()
 	input := &multai.DeleteTargetInput{TargetID: spotinst.String(targetId)}
 
@@ -177,16 +194,16 @@ func deleteTarget(resourceData *schema.ResourceData, meta interface{}) error {
 		log.Printf("===> Target delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.DeleteTarget(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.DeleteTarget(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete target: %s", err)
 	}
 	return nil
 }
 
-func awaitTargetDeleted(targetId *string, client *Client) error {
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+func awaitTargetDeleted(ctx context.Context, targetId *string, timeout time.Duration, client *Client) error {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		input := &multai.ReadTargetInput{TargetID: spotinst.String(*targetId)}
-		resp, err := client.multai.ReadTarget(context.Background(), input)
+		resp, err := client.multai.ReadTarget(ctx, input)
 		if err == nil && resp != nil && resp.Target != nil {
 			return resource.RetryableError(fmt.Errorf("===> waiting for target to delete <==="))
 		}
@@ -28,6 +28,8 @@ func resourceSpotinstMultaiTargetSet() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.MultaiTargetSetResource.GetTimeouts(),
+
 		Schema: commons.MultaiTargetSetResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +51,7 @@ func resourceSpotinstMultaiTargetSetCreate(ctx context.Context, resourceData *sc
 		return diag.FromErr(err)
 	}
 
-	targetSetId, err := createTargetSet(targetSet, meta.(*Client))
+	targetSetId, err := createTargetSet(ctx, resourceData, targetSet, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +62,7 @@ func resourceSpotinstMultaiTargetSetCreate(ctx context.Context, resourceData *sc
 	return resourceSpotinstMultaiTargetSetRead(ctx, resourceData, meta)
 }
 
-func createTargetSet(targetSet *multai.TargetSet, spotinstClient *Client) (*string, error) {
+func createTargetSet(ctx context.Context, resourceData *schema.ResourceData, targetSet *multai.TargetSet, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(targetSet); err != nil {
 		return nil, err
 	} else {
@@ -68,9 +70,9 @@ func createTargetSet(targetSet *multai.TargetSet, spotinstClient *Client) (*stri
 	}
 
 	var resp *multai.CreateTargetSetOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &multai.CreateTargetSetInput{TargetSet: targetSet}
-		r, err := spotinstClient.multai.CreateTargetSet(context.Background(), input)
+		r, err := spotinstClient.multai.CreateTargetSet(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -90,7 +92,7 @@ func resourceSpotinstMultaiTargetSetRead(ctx context.Context, resourceData *sche
 		commons.MultaiTargetSetResource.GetName(), targetSetId)
 
 	input := &multai.ReadTargetSetInput{TargetSetID: spotinst.String(targetSetId)}
-	resp, err := meta.(*Client).multai.ReadTargetSet(context.Background(), input)
+	resp, err := meta.(*Client).multai.ReadTargetSet(ctx, input)
 	if err != nil {
 		return diag.Errorf("failed to read target set: %s", err)
 	}
@@ -122,7 +124,7 @@ func resourceSpotinstMultaiTargetSetUpdate(ctx context.Context, resourceData *sc
 
 	if shouldUpdate {
 		targetSet.SetId(spotinst.String(targetSetId))
-		if err := updateTargetSet(targetSet, resourceData, meta); err != nil {
+		if err := updateTargetSet(ctx, targetSet, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -131,7 +133,7 @@ func resourceSpotinstMultaiTargetSetUpdate(ctx context.Context, resourceData *sc
 	return resourceSpotinstMultaiTargetSetRead(ctx, resourceData, meta)
 }
 
-func updateTargetSet(targetSet *multai.TargetSet, resourceData *schema.ResourceData, meta interface{}) error {
+func updateTargetSet(ctx context.Context, targetSet *multai.TargetSet, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &multai.UpdateTargetSetInput{TargetSet: targetSet}
 	targetSetId := resourceData.Id()
 
@@ -141,7 +143,7 @@ func updateTargetSet(targetSet *multai.TargetSet, resourceData *schema.ResourceD
 		log.Printf("===> Target Set update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.UpdateTargetSet(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.UpdateTargetSet(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update target set [%v]: %v", targetSetId, err)
 	}
 
@@ -153,11 +155,11 @@ func resourceSpotinstMultaiTargetSetDelete(ctx context.Context, resourceData *sc
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.MultaiTargetSetResource.GetName(), targetSetId)
 
-	if err := deleteTargetSet(resourceData, meta); err != nil {
+	if err := deleteTargetSet(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
-	err := awaitTargetSetDeleted(spotinst.String(targetSetId), meta.(*Client))
+	err := awaitTargetSetDeleted(ctx, spotinst.String(targetSetId), resourceData.Timeout(schema.TimeoutDelete), meta.(*Client))
 	if err != nil {
 		return diag.Errorf("[ERROR] Timed out when waiting for the target set to delete. error: %v", err)
 	}
@@ -167,7 +169,7 @@ func resourceSpotinstMultaiTargetSetDelete(ctx context.Context, resourceData *sc
 	return nil
 }
 
-func deleteTargetSet(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteTargetSet(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	targetSetId := resourceData.Id()
 	input := &multai.DeleteTargetSetInput{TargetSetID: spotinst.String(targetSetId)}
 
@@ -177,16 +179,16 @@ func deleteTargetSet(resourceData *schema.ResourceData, meta interface{}) error
 		log.Printf("===> Target Set delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).multai.DeleteTargetSet(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).multai.DeleteTargetSet(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete target set: %s", err)
 	}
 	return nil
 }
 
-func awaitTargetSetDeleted(targetSetId *string, client *Client) error {
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+func awaitTargetSetDeleted(ctx context.Context, targetSetId *string, timeout time.Duration, client *Client) error {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		input := &multai.ReadTargetSetInput{TargetSetID: spotinst.String(*targetSetId)}
-		resp, err := client.multai.ReadTargetSet(context.Background(), input)
+		resp, err := client.multai.ReadTargetSet(ctx, input)
 		if err == nil && resp != nil && resp.TargetSet != nil {
 			return resource.RetryableError(fmt.Errorf("===> waiting for target set to delete <==="))
 		}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,8 +15,9 @@ import (
 
 func init() {
 	resource.AddTestSweepers("spotinst_multai_target_set", &resource.Sweeper{
-		Name: "spotinst_multai_target_set",
-		F:    testSweepMultaiTargetSet,
+		Name:         "spotinst_multai_target_set",
+		F:            testSweepMultaiTargetSet,
+		Dependencies: []string{"spotinst_multai_target"},
 	})
 }
 
@@ -36,7 +36,7 @@ func testSweepMultaiTargetSet(region string) error {
 			log.Printf("[INFO] No target sets to sweep")
 		}
 		for _, set := range resp.TargetSets {
-			if strings.Contains(spotinst.StringValue(set.Name), "test-acc-") {
+			if isSweepable(set.Name) {
 				if _, err := conn.DeleteTargetSet(context.Background(), &multai.DeleteTargetSetInput{TargetSetID: set.ID}); err != nil {
 					return fmt.Errorf("unable to delete target set %v in sweep", spotinst.StringValue(set.ID))
 				} else {
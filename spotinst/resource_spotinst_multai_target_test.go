@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -36,8 +35,8 @@ func testSweepMultaiTarget(region string) error {
 			log.Printf("[INFO] No target to sweep")
 		}
 		for _, tgt := range resp.Targets {
-			if strings.Contains(spotinst.StringValue(tgt.Name), "test-acc-") {
-				if _, err := conn.DeleteTarget(context.Background(), &multai.DeleteTargetInput{TargetSetID: tgt.ID}); err != nil {
+			if isSweepable(tgt.Name) {
+				if _, err := conn.DeleteTarget(context.Background(), &multai.DeleteTargetInput{TargetSetID: tgt.TargetSetID, TargetID: tgt.ID}); err != nil {
 					return fmt.Errorf("unable to delete target %v in sweep", spotinst.StringValue(tgt.ID))
 				} else {
 					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(tgt.ID))
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/ocean_aks"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/ocean_aks_auto_scaling"
@@ -38,9 +40,11 @@ func resourceSpotinstOceanAKS() *schema.Resource {
 		DeleteContext: resourceSpotinstClusterAKSDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByNameStateContext("Ocean cluster", listOceanAKSClusterNames),
 		},
 
+		Timeouts: commons.OceanAKSResource.GetTimeouts(),
+
 		Schema: commons.OceanAKSResource.GetSchemaMap(),
 	}
 }
@@ -69,7 +73,7 @@ func setupClusterAKSResource() {
 func resourceSpotinstClusterAKSCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf(string(commons.ResourceOnCreate), commons.OceanAKSResource.GetName())
 
-	importedCluster, err := importAKSCluster(resourceData, meta.(*Client))
+	importedCluster, err := importAKSCluster(ctx, resourceData, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -79,7 +83,7 @@ func resourceSpotinstClusterAKSCreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createAKSCluster(cluster, meta.(*Client))
+	clusterID, err := createAKSCluster(ctx, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -90,7 +94,7 @@ func resourceSpotinstClusterAKSCreate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterAKSRead(ctx, resourceData, meta)
 }
 
-func createAKSCluster(cluster *azure.Cluster, spotinstClient *Client) (*string, error) {
+func createAKSCluster(ctx context.Context, cluster *azure.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -101,7 +105,7 @@ func createAKSCluster(cluster *azure.Cluster, spotinstClient *Client) (*string,
 		Cluster: cluster,
 	}
 
-	output, err := spotinstClient.ocean.CloudProviderAzure().CreateCluster(context.TODO(), input)
+	output, err := spotinstClient.ocean.CloudProviderAzure().CreateCluster(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("ocean/aks: failed to create cluster: %v", err)
 	}
@@ -113,11 +117,24 @@ func createAKSCluster(cluster *azure.Cluster, spotinstClient *Client) (*string,
 
 // region Read
 
+func listOceanAKSClusterNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderAzure().ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %s", err)
+	}
+
+	clusters := make([]namedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, namedResource{id: spotinst.StringValue(cluster.ID), name: spotinst.StringValue(cluster.Name)})
+	}
+	return clusters, nil
+}
+
 func resourceSpotinstClusterAKSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead), commons.OceanAKSResource.GetName(), clusterID)
 
-	cluster, err := readAKSCluster(context.TODO(), clusterID, meta.(*Client))
+	cluster, err := readAKSCluster(ctx, clusterID, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -181,16 +198,24 @@ func resourceSpotinstClusterAKSUpdate(ctx context.Context, resourceData *schema.
 
 	if shouldUpdate {
 		cluster.SetId(spotinst.String(clusterID))
-		if err := updateAKSCluster(cluster, meta.(*Client)); err != nil {
+		if err := updateAKSCluster(ctx, cluster, meta.(*Client)); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if shouldRollAKS(resourceData, ocean_aks.UpdatePolicy, ocean_aks.ShouldRoll) {
+			if err := rollAKSCluster(ctx, resourceData, meta.(*Client)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_aks.ShouldRoll))
+		}
 	}
 
 	log.Printf("ocean/aks: cluster updated successfully: %s", clusterID)
 	return resourceSpotinstClusterAKSRead(ctx, resourceData, meta)
 }
 
-func updateAKSCluster(cluster *azure.Cluster, spotinstClient *Client) error {
+func updateAKSCluster(ctx context.Context, cluster *azure.Cluster, spotinstClient *Client) error {
 	input := &azure.UpdateClusterInput{
 		Cluster: cluster,
 	}
@@ -201,13 +226,103 @@ func updateAKSCluster(cluster *azure.Cluster, spotinstClient *Client) error {
 		log.Printf("ocean/aks: cluster update configuration: %s", json)
 	}
 
-	if _, err := spotinstClient.ocean.CloudProviderAzure().UpdateCluster(context.TODO(), input); err != nil {
+	if _, err := spotinstClient.ocean.CloudProviderAzure().UpdateCluster(ctx, input); err != nil {
 		return fmt.Errorf("ocean/aks: failed to update cluster: %v", err)
 	}
 
 	return nil
 }
 
+type aksRollSpec struct {
+	BatchSizePercentage       *int     `json:"batchSizePercentage,omitempty"`
+	BatchMinHealthyPercentage *int     `json:"batchMinHealthyPercentage,omitempty"`
+	RespectPDB                *bool    `json:"respectPdb,omitempty"`
+	VirtualNodeGroupIDs       []string `json:"vngIds,omitempty"`
+}
+
+// shouldRollAKS reports whether the should_roll flag of the given update
+// policy is enabled. It is shared by the cluster and virtual node group
+// resources, which both define their own update_policy block.
+func shouldRollAKS(resourceData *schema.ResourceData, updatePolicy, shouldRoll commons.FieldName) bool {
+	if v, exists := resourceData.GetOkExists(string(updatePolicy)); exists {
+		list := v.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			if roll, ok := m[string(shouldRoll)].(bool); ok {
+				return roll
+			}
+		}
+	}
+	return false
+}
+
+func rollAKSCluster(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) error {
+	clusterID := resourceData.Id()
+
+	list := resourceData.Get(string(ocean_aks.UpdatePolicy)).([]interface{})
+	m := list[0].(map[string]interface{})
+	rollConfig, ok := m[string(ocean_aks.RollConfig)].([]interface{})
+	if !ok || len(rollConfig) == 0 || rollConfig[0] == nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Field [%v] is missing, skipping roll for cluster [%v]",
+			string(ocean_aks.RollConfig), clusterID)
+	}
+	rollSpec := rollConfig[0].(map[string]interface{})
+
+	spec := &aksRollSpec{}
+	if v, ok := rollSpec[string(ocean_aks.BatchSizePercentage)].(int); ok { // Required value
+		spec.BatchSizePercentage = spotinst.Int(v)
+	}
+	if v, ok := rollSpec[string(ocean_aks.BatchMinHealthyPercentage)].(int); ok && v > 0 {
+		spec.BatchMinHealthyPercentage = spotinst.Int(v)
+	}
+	if v, ok := rollSpec[string(ocean_aks.RespectPDB)].(bool); ok && v {
+		spec.RespectPDB = spotinst.Bool(v)
+	}
+	if v, ok := rollSpec[string(ocean_aks.VNGIDs)].([]interface{}); ok {
+		for _, id := range v {
+			if id, ok := id.(string); ok && id != "" {
+				spec.VirtualNodeGroupIDs = append(spec.VirtualNodeGroupIDs, id)
+			}
+		}
+	}
+
+	return startAKSClusterRoll(ctx, clusterID, spec, resourceData.Timeout(schema.TimeoutUpdate), spotinstClient)
+}
+
+// startAKSClusterRoll starts a roll of the cluster. The SDK does not cover the
+// roll endpoint of Ocean AKS yet, so the request is sent through the raw API
+// client.
+func startAKSClusterRoll(ctx context.Context, clusterID string, spec *aksRollSpec, timeout time.Duration, spotinstClient *Client) error {
+	if json, err := commons.ToJson(spec); err != nil {
+		return err
+	} else {
+		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, json)
+	}
+
+	path, err := uritemplates.Expand("/ocean/azure/k8s/cluster/{clusterId}/roll", uritemplates.Values{
+		"clusterId": clusterID,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		r := client.NewRequest(http.MethodPost, path)
+		r.Obj = map[string]interface{}{"roll": spec}
+
+		if err := doAPIRequest(ctx, r, spotinstClient); err != nil {
+			return retryRollRequest(ctx, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
+	}
+
+	log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
+	return nil
+}
+
 // endregion
 
 // region Delete
@@ -216,7 +331,7 @@ func resourceSpotinstClusterAKSDelete(ctx context.Context, resourceData *schema.
 	clusterID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.OceanAKSResource.GetName(), clusterID)
 
-	if err := deleteAKSCluster(clusterID, meta.(*Client)); err != nil {
+	if err := deleteAKSCluster(ctx, clusterID, meta.(*Client)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -226,7 +341,7 @@ func resourceSpotinstClusterAKSDelete(ctx context.Context, resourceData *schema.
 	return nil
 }
 
-func deleteAKSCluster(clusterID string, spotinstClient *Client) error {
+func deleteAKSCluster(ctx context.Context, clusterID string, spotinstClient *Client) error {
 	input := &azure.DeleteClusterInput{
 		ClusterID: spotinst.String(clusterID),
 	}
@@ -237,7 +352,7 @@ func deleteAKSCluster(clusterID string, spotinstClient *Client) error {
 		log.Printf("ocean/aks: cluster delete configuration: %s", json)
 	}
 
-	if _, err := spotinstClient.ocean.CloudProviderAzure().DeleteCluster(context.TODO(), input); err != nil {
+	if _, err := spotinstClient.ocean.CloudProviderAzure().DeleteCluster(ctx, input); err != nil {
 		return fmt.Errorf("ocean/aks: failed to delete cluster: %v", err)
 	}
 
@@ -248,9 +363,9 @@ func deleteAKSCluster(clusterID string, spotinstClient *Client) error {
 
 // region Import
 
-func importAKSCluster(resourceData *schema.ResourceData, spotinstClient *Client) (*azure.Cluster, error) {
+func importAKSCluster(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*azure.Cluster, error) {
 	var cluster *azure.Cluster
-	err := resource.RetryContext(context.Background(), time.Hour, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &azure.ImportClusterInput{
 			ACDIdentifier: spotinst.String(resourceData.Get("acd_identifier").(string)),
 			Cluster: &azure.ImportCluster{
@@ -261,7 +376,7 @@ func importAKSCluster(resourceData *schema.ResourceData, spotinstClient *Client)
 					ResourceGroupName: spotinst.String(resourceData.Get("aks_resource_group_name").(string)),
 				}},
 		}
-		output, err := spotinstClient.ocean.CloudProviderAzure().ImportCluster(context.TODO(), input)
+		output, err := spotinstClient.ocean.CloudProviderAzure().ImportCluster(ctx, input)
 		if err != nil {
 			// Check whether the request should be retried.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
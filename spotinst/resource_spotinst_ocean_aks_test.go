@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -15,9 +16,15 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("resource_spotinst_ocean_aks_import", &resource.Sweeper{
-		Name: "resource_spotinst_ocean_aks",
-		F:    testSweepOceanAKSCluster,
+	resource.AddTestSweepers("spotinst_ocean_aks", &resource.Sweeper{
+		Name:         "spotinst_ocean_aks",
+		F:            testSweepOceanAKSCluster,
+		Dependencies: []string{"spotinst_ocean_aks_virtual_node_group"},
+	})
+
+	resource.AddTestSweepers("spotinst_ocean_aks_virtual_node_group", &resource.Sweeper{
+		Name: "spotinst_ocean_aks_virtual_node_group",
+		F:    testSweepOceanAKSVirtualNodeGroup,
 	})
 }
 
@@ -36,7 +43,7 @@ func testSweepOceanAKSCluster(region string) error {
 			log.Printf("[INFO] No clusters to sweep")
 		}
 		for _, cluster := range resp.Clusters {
-			if strings.Contains(spotinst.StringValue(cluster.Name), "terraform-acc-tests-") {
+			if isSweepable(cluster.Name) {
 				if _, err := conn.DeleteCluster(context.Background(), &azure.DeleteClusterInput{ClusterID: cluster.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(cluster.ID))
 				} else {
@@ -48,6 +55,33 @@ func testSweepOceanAKSCluster(region string) error {
 	return nil
 }
 
+func testSweepOceanAKSVirtualNodeGroup(region string) error {
+	client, err := getProviderClient("azure")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).ocean.CloudProviderAzure()
+	input := &azure.ListVirtualNodeGroupsInput{}
+	if resp, err := conn.ListVirtualNodeGroups(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of virtual node groups to sweep")
+	} else {
+		if len(resp.VirtualNodeGroups) == 0 {
+			log.Printf("[INFO] No virtual node groups to sweep")
+		}
+		for _, group := range resp.VirtualNodeGroups {
+			if isSweepable(group.Name) {
+				if _, err := conn.DeleteVirtualNodeGroup(context.Background(), &azure.DeleteVirtualNodeGroupInput{VirtualNodeGroupID: group.ID}); err != nil {
+					return fmt.Errorf("unable to delete virtual node group %v in sweep", spotinst.StringValue(group.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(group.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createOceanAKSResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.OceanAKSResourceName), name)
 }
@@ -113,6 +147,7 @@ type OceanAKSMetadata struct {
 	extensions           string
 	login                string
 	variables            string
+	fieldsToAppend       string
 	updateBaselineFields bool
 }
 
@@ -193,6 +228,7 @@ func createOceanAKSTerraform(clusterMeta *OceanAKSMetadata) string {
 			clusterMeta.health,
 			clusterMeta.loadBalancers,
 			clusterMeta.vmSizes,
+			clusterMeta.fieldsToAppend,
 		)
 	} else {
 		format := testBaselineOceanAKSConfig_Create
@@ -213,6 +249,7 @@ func createOceanAKSTerraform(clusterMeta *OceanAKSMetadata) string {
 			clusterMeta.health,
 			clusterMeta.loadBalancers,
 			clusterMeta.vmSizes,
+			clusterMeta.fieldsToAppend,
 		)
 
 	}
@@ -295,6 +332,7 @@ resource "` + string(commons.OceanAKSResourceName) + `" "%v" {
 %v
 %v
 %v
+%v
 }
 `
 
@@ -321,6 +359,7 @@ resource "` + string(commons.OceanAKSResourceName) + `" "%v" {
 %v
 %v
 %v
+%v
 }
 `
 
@@ -1194,3 +1233,157 @@ const testExtensionsOceanAKSConfig_Update = `
 `
 
 //endregion
+
+// region Ocean AKS : Update Policy
+func TestAccSpotinstOceanAKS_UpdatePolicy(t *testing.T) {
+	clusterName := "terraform-tests-do-not-delete"
+	acdIdentifier := "acd-aa5c6795"
+	controllerClusterID := "terraform-Kubernetes-cluster"
+	resourceName := createOceanAKSResourceName(clusterName)
+
+	var cluster azure.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "azure") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAKSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAKSTerraform(&OceanAKSMetadata{
+					clusterName:         clusterName,
+					acdIdentifier:       acdIdentifier,
+					controllerClusterID: controllerClusterID,
+					fieldsToAppend:      testUpdatePolicyOceanAKSConfig_Create,
+				}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAKSExists(&cluster, resourceName),
+					testCheckOceanAKSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "false"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "33"),
+				),
+			},
+			{
+				Config: createOceanAKSTerraform(&OceanAKSMetadata{
+					clusterName:          clusterName,
+					acdIdentifier:        acdIdentifier,
+					controllerClusterID:  controllerClusterID,
+					fieldsToAppend:       testUpdatePolicyOceanAKSConfig_Update,
+					updateBaselineFields: true,
+				}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_min_healthy_percentage", "100"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.respect_pdb", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testUpdatePolicyOceanAKSConfig_Create = `
+ // --- Update Policy -------------------------------------------------
+    update_policy {
+      should_roll = false
+
+      roll_config {
+        batch_size_percentage = 33
+      }
+    }
+`
+const testUpdatePolicyOceanAKSConfig_Update = `
+ // --- Update Policy -------------------------------------------------
+    update_policy {
+      should_roll = true
+
+      roll_config {
+        batch_size_percentage        = 25
+        batch_min_healthy_percentage = 100
+        respect_pdb                  = true
+      }
+    }
+`
+
+//endregion
+
+func TestUnitSpotinstOceanAKS_Roll(t *testing.T) {
+	rollConfig := []interface{}{map[string]interface{}{
+		"batch_size_percentage":        25,
+		"batch_min_healthy_percentage": 100,
+		"respect_pdb":                  true,
+	}}
+	cases := []struct {
+		resourceName commons.ResourceName
+		id           string
+		config       map[string]interface{}
+		roll         func(context.Context, *schema.ResourceData, *Client) error
+		expectedBody map[string]interface{}
+	}{
+		{
+			resourceName: commons.OceanAKSResourceName,
+			id:           "o-12345678",
+			roll:         rollAKSCluster,
+			expectedBody: map[string]interface{}{
+				"batchSizePercentage":       25.0,
+				"batchMinHealthyPercentage": 100.0,
+				"respectPdb":                true,
+			},
+		},
+		{
+			resourceName: commons.OceanAKSVirtualNodeGroupResourceName,
+			id:           "vng-12345678",
+			config:       map[string]interface{}{"ocean_id": "o-12345678"},
+			roll:         rollAKSVirtualNodeGroup,
+			expectedBody: map[string]interface{}{
+				"batchSizePercentage":       25.0,
+				"batchMinHealthyPercentage": 100.0,
+				"respectPdb":                true,
+				"vngIds":                    []interface{}{"vng-12345678"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(string(c.resourceName), func(t *testing.T) {
+			api := newFakeAPI(t)
+			r := Provider().ResourcesMap[string(c.resourceName)]
+
+			api.objects["/ocean/azure/k8s/cluster"] = map[string]map[string]interface{}{
+				"o-12345678": {"id": "o-12345678"},
+			}
+
+			config := map[string]interface{}{
+				"update_policy": []interface{}{map[string]interface{}{
+					"should_roll": true,
+					"roll_config": rollConfig,
+				}},
+			}
+			for key, value := range c.config {
+				config[key] = value
+			}
+			resourceData := schema.TestResourceDataRaw(t, r.Schema, config)
+			resourceData.SetId(c.id)
+			if err := c.roll(context.Background(), resourceData, api.Client()); err != nil {
+				t.Fatalf("roll failed: %v", err)
+			}
+
+			// Ocean rolls are started on the cluster, with the roll
+			// configuration wrapped in a roll object.
+			actions := api.Actions()
+			if len(actions) != 1 {
+				t.Fatalf("expected a single roll request, got %v", actions)
+			}
+			if got, expected := actions[0].String(), "POST /ocean/azure/k8s/cluster/o-12345678/roll"; got != expected {
+				t.Fatalf("expected the roll to be started with %q, got %q", expected, got)
+			}
+			expectedBody := map[string]interface{}{"roll": c.expectedBody}
+			if !reflect.DeepEqual(actions[0].Body, expectedBody) {
+				t.Fatalf("expected the roll body %v, got %v", expectedBody, actions[0].Body)
+			}
+		})
+	}
+}
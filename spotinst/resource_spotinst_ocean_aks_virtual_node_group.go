@@ -29,6 +29,8 @@ func resourceSpotinstOceanAKSVirtualNodeGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.OceanAKSVirtualNodeGroupResource.GetTimeouts(),
+
 		Schema: commons.OceanAKSVirtualNodeGroupResource.GetSchemaMap(),
 	}
 }
@@ -53,7 +55,7 @@ func resourceSpotinstOceanAKSVirtualNodeGroupCreate(ctx context.Context, resourc
 		return diag.FromErr(err)
 	}
 
-	virtualNodeGroupID, err := createAKSVirtualNodeGroup(context.TODO(), virtualNodeGroup, meta.(*Client))
+	virtualNodeGroupID, err := createAKSVirtualNodeGroup(ctx, virtualNodeGroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -93,7 +95,7 @@ func resourceSpotinstOceanAKSVirtualNodeGroupRead(ctx context.Context, resourceD
 	virtualNodeGroupID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead), commons.OceanAKSVirtualNodeGroupResource.GetName(), virtualNodeGroupID)
 
-	virtualNodeGroup, err := readAKSVirtualNodeGroup(context.TODO(), virtualNodeGroupID, meta.(*Client))
+	virtualNodeGroup, err := readAKSVirtualNodeGroup(ctx, virtualNodeGroupID, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -151,9 +153,18 @@ func resourceSpotinstOceanAKSVirtualNodeGroupUpdate(ctx context.Context, resourc
 
 	if shouldUpdate {
 		virtualNodeGroup.SetId(spotinst.String(virtualNodeGroupID))
-		if err = updateAKSVirtualNodeGroup(context.TODO(), virtualNodeGroup, meta.(*Client)); err != nil {
+		if err = updateAKSVirtualNodeGroup(ctx, virtualNodeGroup, meta.(*Client)); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if shouldRollAKS(resourceData, ocean_aks_virtual_node_group.UpdatePolicy, ocean_aks_virtual_node_group.ShouldRoll) {
+			if err = rollAKSVirtualNodeGroup(ctx, resourceData, meta.(*Client)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("onRoll() -> Field [%v] is false, skipping virtual node group roll",
+				string(ocean_aks_virtual_node_group.ShouldRoll))
+		}
 	}
 
 	log.Printf("ocean/aks: virtual node group updated successfully: %s", virtualNodeGroupID)
@@ -178,6 +189,37 @@ func updateAKSVirtualNodeGroup(ctx context.Context, virtualNodeGroup *azure.Virt
 	return nil
 }
 
+// rollAKSVirtualNodeGroup rolls the nodes of the virtual node group by
+// starting a roll of its cluster that is scoped to the virtual node group.
+func rollAKSVirtualNodeGroup(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) error {
+	virtualNodeGroupID := resourceData.Id()
+
+	list := resourceData.Get(string(ocean_aks_virtual_node_group.UpdatePolicy)).([]interface{})
+	m := list[0].(map[string]interface{})
+	rollConfig, ok := m[string(ocean_aks_virtual_node_group.RollConfig)].([]interface{})
+	if !ok || len(rollConfig) == 0 || rollConfig[0] == nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Field [%v] is missing, skipping roll for virtual node group [%v]",
+			string(ocean_aks_virtual_node_group.RollConfig), virtualNodeGroupID)
+	}
+	rollSpec := rollConfig[0].(map[string]interface{})
+
+	spec := &aksRollSpec{
+		VirtualNodeGroupIDs: []string{virtualNodeGroupID},
+	}
+	if v, ok := rollSpec[string(ocean_aks_virtual_node_group.BatchSizePercentage)].(int); ok { // Required value
+		spec.BatchSizePercentage = spotinst.Int(v)
+	}
+	if v, ok := rollSpec[string(ocean_aks_virtual_node_group.BatchMinHealthyPercentage)].(int); ok && v > 0 {
+		spec.BatchMinHealthyPercentage = spotinst.Int(v)
+	}
+	if v, ok := rollSpec[string(ocean_aks_virtual_node_group.RespectPDB)].(bool); ok && v {
+		spec.RespectPDB = spotinst.Bool(v)
+	}
+
+	oceanID := resourceData.Get(string(ocean_aks_virtual_node_group.OceanID)).(string)
+	return startAKSClusterRoll(ctx, oceanID, spec, resourceData.Timeout(schema.TimeoutUpdate), spotinstClient)
+}
+
 // endregion
 
 // region Delete
@@ -186,7 +228,7 @@ func resourceSpotinstOceanAKSVirtualNodeGroupDelete(ctx context.Context, resourc
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanAKSVirtualNodeGroupResource.GetName(), resourceData.Id())
 
-	if err := deleteAKSVirtualNodeGroup(context.TODO(), resourceData, meta.(*Client)); err != nil {
+	if err := deleteAKSVirtualNodeGroup(ctx, resourceData, meta.(*Client)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -33,8 +33,15 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 		DeleteContext: resourceSpotinstClusterAWSDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDefaults(
+				commons.RollImportDefaults(ocean_aws.RollRequired, ocean_aws.RollRequiredFields),
+				importByNameStateContext("Ocean cluster", listOceanAWSClusterNames)),
 		},
+
+		Timeouts: commons.OceanAWSResource.GetTimeouts(),
+
+		CustomizeDiff: commons.OceanAWSResource.OnDiff,
+
 		Schema: commons.OceanAWSResource.GetSchemaMap(),
 	}
 }
@@ -62,7 +69,7 @@ func resourceSpotinstClusterAWSCreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createAWSCluster(resourceData, cluster, meta.(*Client))
+	clusterID, err := createAWSCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -73,7 +80,7 @@ func resourceSpotinstClusterAWSCreate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterAWSRead(ctx, resourceData, meta)
 }
 
-func createAWSCluster(resourceData *schema.ResourceData, cluster *aws.Cluster, spotinstClient *Client) (*string, error) {
+func createAWSCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *aws.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -82,13 +89,15 @@ func createAWSCluster(resourceData *schema.ResourceData, cluster *aws.Cluster, s
 
 	if v, ok := resourceData.Get(string(ocean_aws_launch_configuration.IAMInstanceProfile)).(string); ok && v != "" {
 		// Wait for IAM instance profile to be ready.
-		time.Sleep(10 * time.Second)
+		if err := sleepContext(ctx, 10*time.Second); err != nil {
+			return nil, err
+		}
 	}
 
 	var resp *aws.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateCluster(ctx, input)
 		if err != nil {
 			// Checks whether we should retry cluster creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -114,13 +123,26 @@ func createAWSCluster(resourceData *schema.ResourceData, cluster *aws.Cluster, s
 
 const ErrCodeClusterNotFound = "CLUSTER_DOESNT_EXIST"
 
+func listOceanAWSClusterNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListClusters(ctx, &aws.ListClustersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %s", err)
+	}
+
+	clusters := make([]namedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, namedResource{id: spotinst.StringValue(cluster.ID), name: spotinst.StringValue(cluster.Name)})
+	}
+	return clusters, nil
+}
+
 func resourceSpotinstClusterAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
 		commons.OceanAWSResource.GetName(), id)
 
 	input := &aws.ReadClusterInput{ClusterID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadCluster(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadCluster(ctx, input)
 
 	if err != nil {
 		// If the cluster was not found, return nil so that we can show
@@ -164,7 +186,7 @@ func resourceSpotinstClusterAWSUpdate(ctx context.Context, resourceData *schema.
 
 	if shouldUpdate {
 		cluster.SetId(spotinst.String(id))
-		if err := updateAWSCluster(cluster, resourceData, meta, changesRequiredRoll, tagsChanged); err != nil {
+		if err := updateAWSCluster(ctx, cluster, resourceData, meta, changesRequiredRoll, tagsChanged); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -172,7 +194,7 @@ func resourceSpotinstClusterAWSUpdate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterAWSRead(ctx, resourceData, meta)
 }
 
-func updateAWSCluster(cluster *aws.Cluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool, tagsChanged bool) error {
+func updateAWSCluster(ctx context.Context, cluster *aws.Cluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool, tagsChanged bool) error {
 	var input = &aws.UpdateClusterInput{
 		Cluster: cluster,
 	}
@@ -206,11 +228,11 @@ func updateAWSCluster(cluster *aws.Cluster, resourceData *schema.ResourceData, m
 		log.Printf("===> Cluster update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update cluster [%v]: %v", clusterID, err)
 	} else if shouldRoll {
 		if !conditionedRoll || changesRequiredRoll || (!autoApplyTags && tagsChanged) {
-			if err := rollOceanAWSCluster(resourceData, meta); err != nil {
+			if err := rollOceanAWSCluster(ctx, resourceData, meta); err != nil {
 				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", clusterID, err)
 				return err
 			}
@@ -222,7 +244,7 @@ func updateAWSCluster(cluster *aws.Cluster, resourceData *schema.ResourceData, m
 	return nil
 }
 
-func rollOceanAWSCluster(resourceData *schema.ResourceData, meta interface{}) error {
+func rollOceanAWSCluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	clusterID := resourceData.Id()
 
 	updatePolicy, exists := resourceData.GetOkExists(string(ocean_aws.UpdatePolicy))
@@ -254,21 +276,136 @@ func rollOceanAWSCluster(resourceData *schema.ResourceData, meta interface{}) er
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &aws.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderAWS().CreateRoll(context.TODO(), rollInput); err != nil {
+		rollOutput, err := meta.(*Client).ocean.CloudProviderAWS().CreateRoll(ctx, rollInput)
+		if err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
-		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
+		if rollOutput == nil || rollOutput.Roll == nil {
+			return fmt.Errorf("onRoll() -> Roll for cluster [%v] returned no roll status", clusterID)
+		}
+
+		rollID := spotinst.StringValue(rollOutput.Roll.ID)
+		if err := setOceanAWSClusterRollStatus(resourceData, rollOutput.Roll); err != nil {
+			return err
+		}
+		log.Printf("onRoll() -> Successfully started roll [%v] for cluster [%v]", rollID, clusterID)
+
+		pctComplete := spotinst.Float64Value(getOceanAWSClusterRollMinPct(rollConfig))
+		if pctComplete <= 0 {
+			return nil
+		}
+
+		pctTimeout := time.Duration(spotinst.IntValue(getOceanAWSClusterRollTimeout(rollConfig))) * time.Second
+		if pctTimeout <= 0 {
+			pctTimeout = resourceData.Timeout(schema.TimeoutUpdate)
+		}
+
+		rollStatus, err := awaitOceanAWSClusterRoll(ctx, clusterID, rollID, pctComplete, pctTimeout, meta.(*Client))
+		if rollStatus != nil {
+			if err := setOceanAWSClusterRollStatus(resourceData, rollStatus); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("onRoll() -> Roll [%v] of cluster [%v] did not complete: %v", rollID, clusterID, err)
+		}
+		log.Printf("onRoll() -> Roll [%v] of cluster [%v] reached %v%%", rollID, clusterID, pctComplete)
 	}
 
 	return nil
 }
 
+func awaitOceanAWSClusterRoll(ctx context.Context, clusterID, rollID string, pctComplete float64, timeout time.Duration, spotinstClient *Client) (*aws.RollStatus, error) {
+	log.Printf("awaitOceanAWSClusterRoll() Waiting for roll [%v] of cluster [%v]", rollID, clusterID)
+
+	if rollID == "" {
+		return nil, fmt.Errorf("invalid roll id: %s", rollID)
+	}
+
+	var rollStatus *aws.RollStatus
+	svc := spotinstClient.ocean.CloudProviderAWS()
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		input := &aws.ReadRollInput{
+			ClusterID: spotinst.String(clusterID),
+			RollID:    spotinst.String(rollID),
+		}
+		output, err := svc.ReadRoll(ctx, input)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("call to roll status of cluster %q failed: %v", clusterID, err))
+		}
+		if output.Roll == nil {
+			return resource.NonRetryableError(fmt.Errorf("roll %q of cluster %q not found", rollID, clusterID))
+		}
+		rollStatus = output.Roll
+
+		status := strings.ToUpper(spotinst.StringValue(rollStatus.Status))
+		if status == "FAILED" || status == "STOPPED" {
+			reason := getOceanAWSClusterRollFailureReason(ctx, clusterID, rollStatus, spotinstClient)
+			return resource.NonRetryableError(fmt.Errorf("roll %s: %s", strings.ToLower(status), reason))
+		}
+
+		var progress float64
+		if rollStatus.Progress != nil {
+			progress = spotinst.Float64Value(rollStatus.Progress.Value)
+		}
+		if status != "COMPLETED" && progress < pctComplete {
+			log.Printf("awaitOceanAWSClusterRoll() Waiting for at least %f%% of batches to complete, current status: %f%%",
+				pctComplete, progress)
+			return resource.RetryableError(fmt.Errorf("roll at %v%% complete", progress))
+		}
+
+		return nil
+	})
+
+	return rollStatus, err
+}
+
+func getOceanAWSClusterRollFailureReason(ctx context.Context, clusterID string, rollStatus *aws.RollStatus, spotinstClient *Client) string {
+	reason := fmt.Sprintf("status %s", spotinst.StringValue(rollStatus.Status))
+	if comment := spotinst.StringValue(rollStatus.Comment); comment != "" {
+		reason = fmt.Sprintf("%s (%s)", reason, comment)
+	}
+
+	// Best effort: the cluster log holds the detailed error of a failed roll.
+	input := &aws.GetLogEventsInput{
+		ClusterID: spotinst.String(clusterID),
+		Severity:  spotinst.String("ERROR"),
+		ToDate:    spotinst.String(time.Now().UTC().Format(time.RFC3339)),
+		Limit:     spotinst.Int(1),
+	}
+	if rollStatus.CreatedAt != nil {
+		input.FromDate = spotinst.String(rollStatus.CreatedAt.UTC().Format(time.RFC3339))
+	}
+	output, err := spotinstClient.ocean.CloudProviderAWS().GetLogEvents(ctx, input)
+	if err != nil {
+		log.Printf("[WARN] Failed to read log events of cluster [%v]: %v", clusterID, err)
+		return reason
+	}
+	for _, event := range output.Events {
+		if msg := spotinst.StringValue(event.Message); msg != "" {
+			return fmt.Sprintf("%s: %s", reason, msg)
+		}
+	}
+
+	return reason
+}
+
+func setOceanAWSClusterRollStatus(resourceData *schema.ResourceData, rollStatus *aws.RollStatus) error {
+	if err := resourceData.Set(string(ocean_aws.RollID), spotinst.StringValue(rollStatus.ID)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws.RollID), err)
+	}
+	if err := resourceData.Set(string(ocean_aws.RollStatus), spotinst.StringValue(rollStatus.Status)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws.RollStatus), err)
+	}
+	return nil
+}
+
 func resourceSpotinstClusterAWSDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanAWSResource.GetName(), id)
 
-	if err := deleteAWSCluster(resourceData, meta); err != nil {
+	if err := deleteAWSCluster(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -277,7 +414,7 @@ func resourceSpotinstClusterAWSDelete(ctx context.Context, resourceData *schema.
 	return nil
 }
 
-func deleteAWSCluster(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteAWSCluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	clusterID := resourceData.Id()
 	input := &aws.DeleteClusterInput{
 		ClusterID: spotinst.String(clusterID),
@@ -289,7 +426,7 @@ func deleteAWSCluster(resourceData *schema.ResourceData, meta interface{}) error
 		log.Printf("===> Cluster delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete cluster: %s", err)
 	}
 	return nil
@@ -308,7 +445,7 @@ func expandOceanAWSClusterRollConfig(data interface{}, clusterID string) (*aws.R
 			spec.BatchSizePercentage = spotinst.Int(v)
 		}
 
-		if v, ok := m[string(ocean_aws.LaunchSpecIDs)].([]string); ok {
+		if v, ok := m[string(ocean_aws.LaunchSpecIDs)].([]interface{}); ok {
 			spec.LaunchSpecIDs = expandOceanAWSLaunchSpecIDs(v)
 		}
 
@@ -332,3 +469,29 @@ func expandOceanAWSLaunchSpecIDs(data interface{}) []string {
 
 	return result
 }
+
+func getOceanAWSClusterRollTimeout(data interface{}) *int {
+	var timeout *int
+	list := data.([]interface{})
+	if len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(ocean_aws.WaitForRollTimeout)].(int); ok {
+			timeout = spotinst.Int(v)
+		}
+	}
+	return timeout
+}
+
+func getOceanAWSClusterRollMinPct(data interface{}) *float64 {
+	var minPct *float64
+	list := data.([]interface{})
+	if len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(ocean_aws.WaitForRollPct)].(float64); ok {
+			minPct = spotinst.Float64(v)
+		}
+	}
+	return minPct
+}
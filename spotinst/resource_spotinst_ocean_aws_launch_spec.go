@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,9 +26,16 @@ func resourceSpotinstOceanAWSLaunchSpec() *schema.Resource {
 		DeleteContext: resourceSpotinstOceanAWSLaunchSpecDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importChildStateContext(childImporter{
+				parentAttribute: string(ocean_aws_launch_spec.OceanID),
+				parentOf:        readOceanAWSLaunchSpecOceanID,
+			}),
 		},
 
+		Timeouts: commons.OceanAWSLaunchSpecResource.GetTimeouts(),
+
+		CustomizeDiff: commons.OceanAWSLaunchSpecResource.OnDiff,
+
 		Schema: commons.OceanAWSLaunchSpecResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +55,7 @@ func resourceSpotinstOceanAWSLaunchSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createLaunchSpec(resourceData, launchSpec, meta.(*Client))
+	launchSpecId, err := createLaunchSpec(ctx, resourceData, launchSpec, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -58,7 +64,7 @@ func resourceSpotinstOceanAWSLaunchSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanAWSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func createLaunchSpec(resourceData *schema.ResourceData, launchSpec *aws.LaunchSpec, spotinstClient *Client) (*string, error) {
+func createLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, launchSpec *aws.LaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -66,7 +72,7 @@ func createLaunchSpec(resourceData *schema.ResourceData, launchSpec *aws.LaunchS
 	}
 
 	var resp *aws.CreateLaunchSpecOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateLaunchSpecInput{LaunchSpec: launchSpec}
 		if createOptions, exists := resourceData.GetOkExists(string(ocean_aws_launch_spec.CreateOptions)); exists {
 			list := createOptions.([]interface{})
@@ -77,7 +83,7 @@ func createLaunchSpec(resourceData *schema.ResourceData, launchSpec *aws.LaunchS
 				}
 			}
 		}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateLaunchSpec(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateLaunchSpec(ctx, input)
 		if err != nil {
 			// Checks whether we should retry launchSpec creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -108,7 +114,7 @@ func resourceSpotinstOceanAWSLaunchSpecRead(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnRead), commons.OceanAWSLaunchSpecResource.GetName(), id)
 
 	input := &aws.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadLaunchSpec(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadLaunchSpec(ctx, input)
 
 	if err != nil {
 		// If the launchSpec was not found, return nil so that we can show
@@ -140,18 +146,30 @@ func resourceSpotinstOceanAWSLaunchSpecRead(ctx context.Context, resourceData *s
 	return nil
 }
 
+func readOceanAWSLaunchSpecOceanID(ctx context.Context, launchSpecID string, meta interface{}) (string, error) {
+	input := &aws.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(launchSpecID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadLaunchSpec(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to read launchSpec: %s", err)
+	}
+	if resp.LaunchSpec == nil {
+		return "", fmt.Errorf("launchSpec %q not found", launchSpecID)
+	}
+	return spotinst.StringValue(resp.LaunchSpec.OceanID), nil
+}
+
 func resourceSpotinstOceanAWSLaunchSpecUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAWSLaunchSpecResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, meta)
+	shouldUpdate, changesRequiredRoll, launchSpec, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		launchSpec.SetId(spotinst.String(id))
-		if err := updateLaunchSpec(launchSpec, resourceData, meta); err != nil {
+		if err := updateLaunchSpec(ctx, launchSpec, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -159,7 +177,7 @@ func resourceSpotinstOceanAWSLaunchSpecUpdate(ctx context.Context, resourceData
 	return resourceSpotinstOceanAWSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
+func updateLaunchSpec(ctx context.Context, launchSpec *aws.LaunchSpec, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &aws.UpdateLaunchSpecInput{
 		LaunchSpec: launchSpec,
 	}
@@ -167,6 +185,7 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 	launchSpecId := resourceData.Id()
 	oceanId := resourceData.Get(string(ocean_aws_launch_spec.OceanID))
 	var shouldRoll = false
+	var conditionedRoll = false
 	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_aws_launch_spec.UpdatePolicy)); exists {
 		list := updatePolicy.([]interface{})
 		if len(list) > 0 && list[0] != nil {
@@ -175,6 +194,10 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 			if roll, ok := m[string(ocean_aws_launch_spec.ShouldRoll)].(bool); ok && roll {
 				shouldRoll = roll
 			}
+
+			if condRoll, ok := m[string(ocean_aws_launch_spec.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
 		}
 	}
 
@@ -184,12 +207,16 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 		log.Printf("===> launchSpec update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec [%v]: %v", launchSpecId, err)
 	} else if shouldRoll {
-		if err := rollOceanAWSLaunchSpec(resourceData, meta); err != nil {
-			log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
-			return err
+		if !conditionedRoll || changesRequiredRoll {
+			if err := rollOceanAWSLaunchSpec(ctx, resourceData, meta); err != nil {
+				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
+				return err
+			}
+		} else {
+			log.Printf("onRoll() -> No field requiring a roll changed, skipping roll of launchSpec [%v]", launchSpecId)
 		}
 	} else {
 		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_aws_launch_spec.ShouldRoll))
@@ -198,7 +225,7 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 	return nil
 }
 
-func rollOceanAWSLaunchSpec(resourceData *schema.ResourceData, meta interface{}) error {
+func rollOceanAWSLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	specID := resourceData.Id()
 	clusterID := resourceData.Get(string(ocean_aws_launch_spec.OceanID)).(string)
 
@@ -231,7 +258,7 @@ func rollOceanAWSLaunchSpec(resourceData *schema.ResourceData, meta interface{})
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &aws.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderAWS().CreateRoll(context.TODO(), rollInput); err != nil {
+		if _, err = meta.(*Client).ocean.CloudProviderAWS().CreateRoll(ctx, rollInput); err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
@@ -245,7 +272,7 @@ func resourceSpotinstOceanAWSLaunchSpecDelete(ctx context.Context, resourceData
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanAWSLaunchSpecResource.GetName(), id)
 
-	if err := deleteLaunchSpec(resourceData, meta); err != nil {
+	if err := deleteLaunchSpec(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -254,7 +281,7 @@ func resourceSpotinstOceanAWSLaunchSpecDelete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteLaunchSpec(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	launchSpecId := resourceData.Id()
 	input := &aws.DeleteLaunchSpecInput{
 		LaunchSpecID: spotinst.String(launchSpecId),
@@ -276,7 +303,7 @@ func deleteLaunchSpec(resourceData *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete launchSpecId: %s", err)
 	}
 	return nil
@@ -14,39 +14,39 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/elastigroup_aws_launch_configuration"
 )
 
-//func init() {
-//	resource.AddTestSweepers("spotinst_ocean_aws_launch_spec", &resource.Sweeper{
-//		Name: "spotinst_ocean_aws_launch_spec",
-//		F:    testSweepOceanAWSLaunchSpec,
-//	})
-//}
-//
-//func testSweepOceanAWSLaunchSpec(region string) error {
-//	client, err := getProviderClient("aws")
-//	if err != nil {
-//		return fmt.Errorf("error getting client: %v", err)
-//	}
-//
-//	conn := client.(*Client).ocean.CloudProviderAWS()
-//	input := &aws.ListLaunchSpecsInput{}
-//	if resp, err := conn.ListLaunchSpecs(context.Background(), input); err != nil {
-//		return fmt.Errorf("error getting list of launch specs to sweep")
-//	} else {
-//		if len(resp.LaunchSpecs) == 0 {
-//			log.Printf("[INFO] No launch specs to sweep")
-//		}
-//		for _, launchSpec := range resp.LaunchSpecs {
-//			if strings.Contains(spotinst.StringValue(launchSpec.<WHAT>), "test-acc-") {
-//				if _, err := conn.DeleteLaunchSpec(context.Background(), &aws.DeleteLaunchSpecInput{LaunchSpecID: launchSpec.ID}); err != nil {
-//					return fmt.Errorf("unable to delete launch spec %v in sweep", spotinst.StringValue(launchSpec.ID))
-//				} else {
-//					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(launchSpec.ID))
-//				}
-//			}
-//		}
-//	}
-//	return nil
-//}
+func init() {
+	resource.AddTestSweepers("spotinst_ocean_aws_launch_spec", &resource.Sweeper{
+		Name: "spotinst_ocean_aws_launch_spec",
+		F:    testSweepOceanAWSLaunchSpec,
+	})
+}
+
+func testSweepOceanAWSLaunchSpec(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).ocean.CloudProviderAWS()
+	input := &aws.ListLaunchSpecsInput{}
+	if resp, err := conn.ListLaunchSpecs(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of launch specs to sweep")
+	} else {
+		if len(resp.LaunchSpecs) == 0 {
+			log.Printf("[INFO] No launch specs to sweep")
+		}
+		for _, launchSpec := range resp.LaunchSpecs {
+			if isSweepable(launchSpec.Name) {
+				if _, err := conn.DeleteLaunchSpec(context.Background(), &aws.DeleteLaunchSpecInput{LaunchSpecID: launchSpec.ID, ForceDelete: spotinst.Bool(true)}); err != nil {
+					return fmt.Errorf("unable to delete launch spec %v in sweep", spotinst.StringValue(launchSpec.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(launchSpec.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
 
 func createOceanAWSLaunchSpecResourceOceanID(oceanID string) string {
 	return fmt.Sprintf("%v.%v", string(commons.OceanAWSLaunchSpecResourceName), oceanID)
@@ -196,7 +196,7 @@ func TestAccSpotinstOceanAWSLaunchSpec_Baseline(t *testing.T) {
 					testCheckOceanAWSLaunchSpecExists(&launchSpec, resourceName),
 					testCheckOceanAWSLaunchSpecAttributes(&launchSpec, oceanID),
 					resource.TestCheckResourceAttr(resourceName, "image_id", "ami-05f840082fe2dcac2"),
-					resource.TestCheckResourceAttr(resourceName, "name", "launch spec name test update"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-acc-launch-spec-update"),
 					resource.TestCheckResourceAttr(resourceName, "instance_types.#", "5"),
 					resource.TestCheckResourceAttr(resourceName, "instance_types.0", "m3.2xlarge"),
 					resource.TestCheckResourceAttr(resourceName, "instance_types.1", "m4.xlarge"),
@@ -274,7 +274,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
   preferred_spot_types = ["m3.2xlarge","m4.xlarge"]
   security_groups = ["sg-0041bd3fd6aa2ee3c","sg-0195f2ac3a6014a15" ]
   root_volume_size = 30
-  name = "launch spec name test update"
+  name = "test-acc-launch-spec-update"
   associate_public_ip_address = false
   restrict_scale_down = false
 
@@ -374,7 +374,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  autoscale_headrooms {
    cpu_per_unit = 1024
@@ -412,7 +412,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  autoscale_headrooms {
    cpu_per_unit = 1024
@@ -438,7 +438,7 @@ const testAutoScaleOceanAWSLaunchSpecConfig_Delete = `
 resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  provider = "%v"
  ocean_id = "%v"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  image_id = "ami-05f840082fe2dcac2"
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
@@ -506,7 +506,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  elastic_ip_pool {
    tag_selector {
@@ -529,7 +529,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  elastic_ip_pool {
    tag_selector {
@@ -547,7 +547,7 @@ const testLaunchSpecOceanAWSElasticIpPool_Delete = `
 resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  provider = "%v"
  ocean_id = "%v"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  image_id = "ami-05f840082fe2dcac2"
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
@@ -637,7 +637,7 @@ image_id = "ami-05f840082fe2dcac2"
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
 	block_device_mappings {
         device_name = "/dev/xvda1"
@@ -667,7 +667,7 @@ image_id = "ami-05f840082fe2dcac2"
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
 	block_device_mappings {
         device_name = "/dev/xvda1"
@@ -692,7 +692,7 @@ const testLaunchSpecOceanBlockDeviceMappings_EmptyFields = `
 resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
 provider = "%v"
 ocean_id = "%v"
-name = "launch spec name test"
+name = "test-acc-launch-spec"
 
 image_id = "ami-05f840082fe2dcac2"
 security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
@@ -763,7 +763,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
   resource_limits {
     max_instance_count = 5
@@ -784,7 +784,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
   resource_limits {
     max_instance_count = 4
@@ -800,7 +800,7 @@ const testLaunchSpecOceanAWSResourceLimits_Delete = `
 resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  provider = "%v"
  ocean_id = "%v"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  image_id = "ami-05f840082fe2dcac2"
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
@@ -860,7 +860,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  strategy {
   spot_percentage = 70
@@ -880,7 +880,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
  strategy {
   spot_percentage = 30
@@ -958,7 +958,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
   scheduling_task {
     is_enabled = true
@@ -988,7 +988,7 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
  security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
  user_data = "hello world updated"
  iam_instance_profile = "updated"
- name = "launch spec name test"
+ name = "test-acc-launch-spec"
 
   scheduling_task {
     is_enabled = true
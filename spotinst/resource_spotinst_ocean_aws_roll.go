@@ -0,0 +1,210 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/ocean_aws_roll"
+)
+
+func resourceSpotinstOceanAWSRoll() *schema.Resource {
+	setupOceanAWSRollResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstOceanAWSRollCreate,
+		ReadContext:   resourceSpotinstOceanAWSRollRead,
+		DeleteContext: resourceSpotinstOceanAWSRollDelete,
+
+		Timeouts: commons.OceanAWSRollResource.GetTimeouts(),
+
+		Schema: commons.OceanAWSRollResource.GetSchemaMap(),
+	}
+}
+
+func setupOceanAWSRollResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+	ocean_aws_roll.Setup(fieldsMap)
+
+	commons.OceanAWSRollResource = commons.NewOceanAWSRollResource(fieldsMap)
+}
+
+func resourceSpotinstOceanAWSRollCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate), commons.OceanAWSRollResource.GetName())
+
+	roll, err := commons.OceanAWSRollResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID := spotinst.StringValue(roll.ClusterID)
+	rollStatus, err := startOceanAWSRoll(ctx, roll, resourceData.Timeout(schema.TimeoutCreate), meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rollID := spotinst.StringValue(rollStatus.ID)
+	resourceData.SetId(rollID)
+	if err := setOceanAWSRollStatus(resourceData, rollStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("onRoll() -> Successfully started roll [%v] for cluster [%v]", rollID, clusterID)
+
+	// The roll is kept in the state when it does not complete, so it is
+	// tainted and started again on the next apply.
+	rollStatus, err = awaitOceanAWSClusterRoll(ctx, clusterID, rollID, 100, resourceData.Timeout(schema.TimeoutCreate), meta.(*Client))
+	if rollStatus != nil {
+		if err := setOceanAWSRollStatus(resourceData, rollStatus); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err != nil {
+		return diag.Errorf("onRoll() -> Roll [%v] of cluster [%v] did not complete: %v", rollID, clusterID, err)
+	}
+
+	log.Printf("===> Roll created successfully: %s <===", rollID)
+	return resourceSpotinstOceanAWSRollRead(ctx, resourceData, meta)
+}
+
+// startOceanAWSRoll starts a roll of the cluster. The SDK does not support
+// respectPdb yet, so the request is sent through the raw API client.
+func startOceanAWSRoll(ctx context.Context, roll *commons.OceanAWSRollSpec, timeout time.Duration, spotinstClient *Client) (*aws.RollStatus, error) {
+	clusterID := spotinst.StringValue(roll.ClusterID)
+	if json, err := commons.ToJson(roll); err != nil {
+		return nil, err
+	} else {
+		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, json)
+	}
+
+	path, err := uritemplates.Expand("/ocean/aws/k8s/cluster/{clusterId}/roll", uritemplates.Values{
+		"clusterId": clusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cluster is part of the path, not of the roll.
+	spec := *roll
+	spec.ClusterID = nil
+
+	var rollStatus *aws.RollStatus
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		r := client.NewRequest(http.MethodPost, path)
+		r.Obj = map[string]interface{}{"roll": spec}
+
+		status, err := doOceanAWSRollRequest(ctx, r, spotinstClient)
+		if err != nil {
+			return retryRollRequest(ctx, err)
+		}
+		rollStatus = status
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
+	}
+
+	return rollStatus, nil
+}
+
+func doOceanAWSRollRequest(ctx context.Context, r *client.Request, spotinstClient *Client) (*aws.RollStatus, error) {
+	resp, err := client.RequireOK(spotinstClient.api.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var out client.Response
+	if err := client.DecodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Response.Items) == 0 {
+		return nil, fmt.Errorf("empty roll status response")
+	}
+
+	status := new(aws.RollStatus)
+	if err := json.Unmarshal(out.Response.Items[0], status); err != nil {
+		return nil, err
+	}
+	if spotinst.StringValue(status.ID) == "" {
+		return nil, fmt.Errorf("roll status response has no roll id")
+	}
+	return status, nil
+}
+
+func resourceSpotinstOceanAWSRollRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rollID := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), commons.OceanAWSRollResource.GetName(), rollID)
+
+	clusterID := resourceData.Get(string(ocean_aws_roll.OceanID)).(string)
+	input := &aws.ReadRollInput{
+		ClusterID: spotinst.String(clusterID),
+		RollID:    spotinst.String(rollID),
+	}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadRoll(ctx, input)
+	if err != nil {
+		// If the cluster was not found, its rolls are gone as well.
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeClusterNotFound {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+		return diag.Errorf("failed to read roll %q of cluster %q: %s", rollID, clusterID, err)
+	}
+
+	// If nothing was found, then return no state.
+	rollStatus := resp.Roll
+	if rollStatus == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	roll := &commons.OceanAWSRollSpec{ClusterID: rollStatus.ClusterID}
+	if err := commons.OceanAWSRollResource.OnRead(roll, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setOceanAWSRollStatus(resourceData, rollStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Roll read successfully: %s <===", rollID)
+	return nil
+}
+
+func setOceanAWSRollStatus(resourceData *schema.ResourceData, rollStatus *aws.RollStatus) error {
+	if err := resourceData.Set(string(ocean_aws_roll.Status), spotinst.StringValue(rollStatus.Status)); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws_roll.Status), err)
+	}
+
+	var progress float64
+	if rollStatus.Progress != nil {
+		progress = spotinst.Float64Value(rollStatus.Progress.Value)
+	}
+	if err := resourceData.Set(string(ocean_aws_roll.Progress), progress); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws_roll.Progress), err)
+	}
+	return nil
+}
+
+// resourceSpotinstOceanAWSRollDelete removes the roll from the state. A
+// completed roll cannot be undone, so nothing is sent to the API.
+func resourceSpotinstOceanAWSRollDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnDelete), commons.OceanAWSRollResource.GetName(), resourceData.Id())
+
+	resourceData.SetId("")
+	return nil
+}
@@ -0,0 +1,147 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSRollResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanAWSRollResourceName), name)
+}
+
+func testCheckOceanAWSRollReplaced(previousID *string, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		if rs.Primary.ID == *previousID {
+			return fmt.Errorf("expected a new roll, got roll %s again", rs.Primary.ID)
+		}
+		*previousID = rs.Primary.ID
+		return nil
+	}
+}
+
+func createOceanAWSRollTerraform(oceanID, ami string) string {
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+	template += fmt.Sprintf(testBaselineOceanAWSRollConfig, "baseline", oceanID, ami)
+
+	log.Printf("Terraform roll template:\n%v", template)
+	return template
+}
+
+// region OceanAWSRoll: Baseline
+func TestAccSpotinstOceanAWSRoll_Baseline(t *testing.T) {
+	oceanID := "o-323b5842"
+	resourceName := createOceanAWSRollResourceName("baseline")
+
+	var rollID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSRollTerraform(oceanID, "ami-05f840082fe2dcac2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSRollReplaced(&rollID, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ocean_id", oceanID),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "progress", "100"),
+				),
+			},
+			{
+				Config: createOceanAWSRollTerraform(oceanID, "ami-0c3f4b5e8ea2a4e5b"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSRollReplaced(&rollID, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanAWSRollConfig = `
+resource "` + string(commons.OceanAWSRollResourceName) + `" "%v" {
+  provider = "aws"
+
+  ocean_id                     = "%v"
+  batch_size_percentage        = 50
+  batch_min_healthy_percentage = 50
+  respect_pdb                  = true
+
+  triggers = {
+    image_id = "%v"
+  }
+}
+`
+
+// endregion
+
+func TestUnitSpotinstOceanAWSRoll_Lifecycle(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.Client()
+	r := Provider().ResourcesMap[string(commons.OceanAWSRollResourceName)]
+	ctx := context.Background()
+
+	api.objects["/ocean/aws/k8s/cluster"] = map[string]map[string]interface{}{
+		"o-12345678": {"id": "o-12345678", "name": "fake-cluster"},
+	}
+
+	config := map[string]interface{}{
+		"ocean_id":              "o-12345678",
+		"launch_spec_ids":       []interface{}{"ols-12345678"},
+		"batch_size_percentage": 20,
+		"respect_pdb":           true,
+		"triggers":              map[string]interface{}{"image_id": "ami-123456"},
+	}
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(ctx, resourceData, meta); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	if resourceData.Id() == "" {
+		t.Fatalf("expected the roll ID to be set")
+	}
+	if status := resourceData.Get("status"); status != "COMPLETED" {
+		t.Fatalf("expected the roll to be awaited until completed, got status %v", status)
+	}
+	if progress := resourceData.Get("progress"); progress != 100.0 {
+		t.Fatalf("expected the roll to be 100%% complete, got %v", progress)
+	}
+
+	// A change of the triggers starts a new roll.
+	config["triggers"] = map[string]interface{}{"image_id": "ami-654321"}
+	diff, err := r.Diff(ctx, resourceData.State(), terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("plan failed: %v", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a change of the triggers to replace the roll, got %v", diff)
+	}
+
+	// The rolls of a deleted cluster are removed from the state.
+	delete(api.objects["/ocean/aws/k8s/cluster"], "o-12345678")
+	if diags := r.ReadContext(ctx, resourceData, meta); diags.HasError() {
+		t.Fatalf("read of a roll of a deleted cluster failed: %v", diags)
+	}
+	if resourceData.Id() != "" {
+		t.Fatalf("expected the roll of a deleted cluster to be removed from the state")
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -17,8 +16,9 @@ import (
 
 func init() {
 	resource.AddTestSweepers("spotinst_ocean_aws", &resource.Sweeper{
-		Name: "spotinst_ocean_aws",
-		F:    testSweepOceanAWS,
+		Name:         "spotinst_ocean_aws",
+		F:            testSweepOceanAWS,
+		Dependencies: []string{"spotinst_ocean_aws_launch_spec"},
 	})
 }
 
@@ -37,7 +37,7 @@ func testSweepOceanAWS(region string) error {
 			log.Printf("[INFO] No clusters to sweep")
 		}
 		for _, cluster := range resp.Clusters {
-			if strings.Contains(spotinst.StringValue(cluster.Name), "test-acc-") {
+			if isSweepable(cluster.Name) {
 				if _, err := conn.DeleteCluster(context.Background(), &aws.DeleteClusterInput{ClusterID: cluster.ID}); err != nil {
 					return fmt.Errorf("unable to delete cluster %v in sweep", spotinst.StringValue(cluster.ID))
 				} else {
@@ -36,8 +36,15 @@ func resourceSpotinstOceanECS() *schema.Resource {
 		UpdateContext: resourceSpotinstClusterECSUpdate,
 		DeleteContext: resourceSpotinstClusterECSDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDefaults(
+				commons.RollImportDefaults(ocean_ecs.RollRequired, ocean_ecs.RollRequiredFields),
+				importByNameStateContext("Ocean cluster", listOceanECSClusterNames)),
 		},
+
+		Timeouts: commons.OceanECSResource.GetTimeouts(),
+
+		CustomizeDiff: commons.OceanECSResource.OnDiff,
+
 		Schema: commons.OceanECSResource.GetSchemaMap(),
 	}
 }
@@ -65,7 +72,7 @@ func resourceSpotinstClusterECSCreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createECSCluster(resourceData, cluster, meta.(*Client))
+	clusterID, err := createECSCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -76,7 +83,7 @@ func resourceSpotinstClusterECSCreate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterECSRead(ctx, resourceData, meta)
 }
 
-func createECSCluster(resourceData *schema.ResourceData, cluster *aws.ECSCluster, spotinstClient *Client) (*string, error) {
+func createECSCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *aws.ECSCluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -85,13 +92,15 @@ func createECSCluster(resourceData *schema.ResourceData, cluster *aws.ECSCluster
 
 	if v, ok := resourceData.Get(string(ocean_ecs_launch_specification.IamInstanceProfile)).(string); ok && v != "" {
 		// Wait for IAM instance profile to be ready.
-		time.Sleep(10 * time.Second)
+		if err := sleepContext(ctx, 10*time.Second); err != nil {
+			return nil, err
+		}
 	}
 
 	var resp *aws.CreateECSClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateECSClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSCluster(ctx, input)
 		if err != nil {
 			// Checks whether we should retry cluster creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -117,13 +126,26 @@ func createECSCluster(resourceData *schema.ResourceData, cluster *aws.ECSCluster
 
 const ErrCodeECSClusterNotFound = "CANT_GET_OCEAN_CLUSTER"
 
+func listOceanECSClusterNames(ctx context.Context, meta interface{}) ([]namedResource, error) {
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListECSClusters(ctx, &aws.ListECSClustersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %s", err)
+	}
+
+	clusters := make([]namedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, namedResource{id: spotinst.StringValue(cluster.ID), name: spotinst.StringValue(cluster.Name)})
+	}
+	return clusters, nil
+}
+
 func resourceSpotinstClusterECSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
 		commons.OceanECSResource.GetName(), id)
 
 	input := &aws.ReadECSClusterInput{ClusterID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadECSCluster(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadECSCluster(ctx, input)
 
 	if err != nil {
 		// If the cluster was not found, return nil so that we can show
@@ -167,7 +189,7 @@ func resourceSpotinstClusterECSUpdate(ctx context.Context, resourceData *schema.
 
 	if shouldUpdate {
 		cluster.SetId(spotinst.String(id))
-		if err := updateECSCluster(cluster, resourceData, meta, changesRequiredRoll, tagsChanged); err != nil {
+		if err := updateECSCluster(ctx, cluster, resourceData, meta, changesRequiredRoll, tagsChanged); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -175,7 +197,7 @@ func resourceSpotinstClusterECSUpdate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterECSRead(ctx, resourceData, meta)
 }
 
-func updateECSCluster(cluster *aws.ECSCluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool, tagsChanged bool) error {
+func updateECSCluster(ctx context.Context, cluster *aws.ECSCluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool, tagsChanged bool) error {
 	var input = &aws.UpdateECSClusterInput{
 		Cluster: cluster,
 	}
@@ -209,11 +231,11 @@ func updateECSCluster(cluster *aws.ECSCluster, resourceData *schema.ResourceData
 		log.Printf("===> Cluster update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateECSCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateECSCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update cluster [%v]: %v", clusterID, err)
 	} else if shouldRoll {
 		if !conditionedRoll || changesRequiredRoll || (!autoApplyTags && tagsChanged) {
-			if err := rollECSCluster(resourceData, meta); err != nil {
+			if err := rollECSCluster(ctx, resourceData, meta); err != nil {
 				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", clusterID, err)
 				return err
 			}
@@ -225,7 +247,7 @@ func updateECSCluster(cluster *aws.ECSCluster, resourceData *schema.ResourceData
 	return nil
 }
 
-func rollECSCluster(resourceData *schema.ResourceData, meta interface{}) error {
+func rollECSCluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	var errResult error = nil
 	clusterID := resourceData.Id()
 
@@ -244,7 +266,7 @@ func rollECSCluster(resourceData *schema.ResourceData, meta interface{}) error {
 					} else {
 						log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, json)
 						rollClusterInput.Roll.ClusterID = spotinst.String(clusterID)
-						_, err := meta.(*Client).ocean.CloudProviderAWS().RollECS(context.Background(), rollClusterInput)
+						_, err := meta.(*Client).ocean.CloudProviderAWS().RollECS(ctx, rollClusterInput)
 						if err != nil {
 							return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 						} else {
@@ -269,7 +291,7 @@ func resourceSpotinstClusterECSDelete(ctx context.Context, resourceData *schema.
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanECSResource.GetName(), id)
 
-	if err := deleteECSCluster(resourceData, meta); err != nil {
+	if err := deleteECSCluster(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -278,7 +300,7 @@ func resourceSpotinstClusterECSDelete(ctx context.Context, resourceData *schema.
 	return nil
 }
 
-func deleteECSCluster(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteECSCluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	clusterID := resourceData.Id()
 	input := &aws.DeleteECSClusterInput{
 		ClusterID: spotinst.String(clusterID),
@@ -290,7 +312,7 @@ func deleteECSCluster(resourceData *schema.ResourceData, meta interface{}) error
 		log.Printf("===> Cluster delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteECSCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteECSCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete cluster: %s", err)
 	}
 	return nil
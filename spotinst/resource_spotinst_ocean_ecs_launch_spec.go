@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,9 +26,16 @@ func resourceSpotinstOceanECSLaunchSpec() *schema.Resource {
 		DeleteContext: resourceSpotinstOceanECSLaunchSpecDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importChildStateContext(childImporter{
+				parentAttribute: string(ocean_ecs_launch_spec.OceanID),
+				parentOf:        readOceanECSLaunchSpecOceanID,
+			}),
 		},
 
+		Timeouts: commons.OceanECSLaunchSpecResource.GetTimeouts(),
+
+		CustomizeDiff: commons.OceanECSLaunchSpecResource.OnDiff,
+
 		Schema: commons.OceanECSLaunchSpecResource.GetSchemaMap(),
 	}
 }
@@ -49,7 +55,7 @@ func resourceSpotinstOceanECSLaunchSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createECSLaunchSpec(launchSpec, meta.(*Client))
+	launchSpecId, err := createECSLaunchSpec(ctx, resourceData, launchSpec, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -58,7 +64,7 @@ func resourceSpotinstOceanECSLaunchSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanECSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func createECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, spotinstClient *Client) (*string, error) {
+func createECSLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, launchSpec *aws.ECSLaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -66,9 +72,9 @@ func createECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, spotinstClient *Client)
 	}
 
 	var resp *aws.CreateECSLaunchSpecOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateECSLaunchSpecInput{LaunchSpec: launchSpec}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSLaunchSpec(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSLaunchSpec(ctx, input)
 		if err != nil {
 			// Checks whether we should retry launchSpec creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -99,7 +105,7 @@ func resourceSpotinstOceanECSLaunchSpecRead(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnRead), commons.OceanECSLaunchSpecResource.GetName(), id)
 
 	input := &aws.ReadECSLaunchSpecInput{LaunchSpecID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadECSLaunchSpec(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadECSLaunchSpec(ctx, input)
 
 	if err != nil {
 		// If the launchSpec was not found, return nil so that we can show
@@ -131,17 +137,29 @@ func resourceSpotinstOceanECSLaunchSpecRead(ctx context.Context, resourceData *s
 	return nil
 }
 
+func readOceanECSLaunchSpecOceanID(ctx context.Context, launchSpecID string, meta interface{}) (string, error) {
+	input := &aws.ReadECSLaunchSpecInput{LaunchSpecID: spotinst.String(launchSpecID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadECSLaunchSpec(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to read launchSpec: %s", err)
+	}
+	if resp.LaunchSpec == nil {
+		return "", fmt.Errorf("launchSpec %q not found", launchSpecID)
+	}
+	return spotinst.StringValue(resp.LaunchSpec.OceanID), nil
+}
+
 func resourceSpotinstOceanECSLaunchSpecUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanECSLaunchSpecResource.GetName(), id)
-	shouldUpdate, launchSpec, err := commons.OceanECSLaunchSpecResource.OnUpdate(resourceData, meta)
+	shouldUpdate, changesRequiredRoll, launchSpec, err := commons.OceanECSLaunchSpecResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		launchSpec.SetId(spotinst.String(id))
-		if err := updateECSLaunchSpec(launchSpec, resourceData, meta); err != nil {
+		if err := updateECSLaunchSpec(ctx, launchSpec, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -149,12 +167,28 @@ func resourceSpotinstOceanECSLaunchSpecUpdate(ctx context.Context, resourceData
 	return resourceSpotinstOceanECSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func updateECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
+func updateECSLaunchSpec(ctx context.Context, launchSpec *aws.ECSLaunchSpec, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &aws.UpdateECSLaunchSpecInput{
 		LaunchSpec: launchSpec,
 	}
 
 	launchSpecId := resourceData.Id()
+	var shouldRoll = false
+	var conditionedRoll = false
+	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_ecs_launch_spec.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+
+			if roll, ok := m[string(ocean_ecs_launch_spec.ShouldRoll)].(bool); ok && roll {
+				shouldRoll = roll
+			}
+
+			if condRoll, ok := m[string(ocean_ecs_launch_spec.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
+		}
+	}
 
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return err
@@ -162,8 +196,61 @@ func updateECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, resourceData *schema.Res
 		log.Printf("===> launchSpec update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateECSLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateECSLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec [%v]: %v", launchSpecId, err)
+	} else if shouldRoll {
+		if !conditionedRoll || changesRequiredRoll {
+			if err := rollOceanECSLaunchSpec(ctx, resourceData, meta); err != nil {
+				log.Printf("[ERROR] LaunchSpec [%v] roll failed, error: %v", launchSpecId, err)
+				return err
+			}
+		} else {
+			log.Printf("onRoll() -> No field requiring a roll changed, skipping roll of launchSpec [%v]", launchSpecId)
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping roll of launchSpec [%v]", string(ocean_ecs_launch_spec.ShouldRoll), launchSpecId)
+	}
+
+	return nil
+}
+
+func rollOceanECSLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	specID := resourceData.Id()
+	clusterID := resourceData.Get(string(ocean_ecs_launch_spec.OceanID)).(string)
+
+	updatePolicy, exists := resourceData.GetOkExists(string(ocean_ecs_launch_spec.UpdatePolicy))
+	if !exists {
+		return fmt.Errorf("ocean/ecs: missing update policy for launchSpec %q", specID)
+	}
+
+	list := updatePolicy.([]interface{})
+	if len(list) > 0 && list[0] != nil {
+		updatePolicySchema := list[0].(map[string]interface{})
+
+		rollConfig, ok := updatePolicySchema[string(ocean_ecs_launch_spec.RollConfig)]
+		if !ok || rollConfig == nil {
+			return fmt.Errorf("ocean/ecs: missing roll configuration, "+
+				"skipping roll for launchSpec %q", specID)
+		}
+
+		rollSpec, err := expandOceanECSLaunchSpecRollConfig(rollConfig, clusterID, specID)
+		if err != nil {
+			return fmt.Errorf("ocean/ecs: failed expanding roll "+
+				"configuration for launchSpec %q, error: %v", specID, err)
+		}
+
+		rollJSON, err := commons.ToJson(rollConfig)
+		if err != nil {
+			return fmt.Errorf("ocean/ecs: failed marshaling roll "+
+				"configuration for launchSpec %q, error: %v", specID, err)
+		}
+
+		log.Printf("onRoll() -> Rolling launchSpec [%v] of cluster [%v] with configuration %s", specID, clusterID, rollJSON)
+		rollInput := &aws.ECSRollClusterInput{Roll: rollSpec}
+		if _, err = meta.(*Client).ocean.CloudProviderAWS().RollECS(ctx, rollInput); err != nil {
+			return fmt.Errorf("onRoll() -> Roll failed for launchSpec [%v], error: %v", specID, err)
+		}
+		log.Printf("onRoll() -> Successfully rolled launchSpec [%v]", specID)
 	}
 
 	return nil
@@ -174,7 +261,7 @@ func resourceSpotinstOceanECSLaunchSpecDelete(ctx context.Context, resourceData
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanECSLaunchSpecResource.GetName(), id)
 
-	if err := deleteECSLaunchSpec(resourceData, meta); err != nil {
+	if err := deleteECSLaunchSpec(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -183,7 +270,7 @@ func resourceSpotinstOceanECSLaunchSpecDelete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteECSLaunchSpec(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteECSLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	launchSpecId := resourceData.Id()
 	input := &aws.DeleteECSLaunchSpecInput{
 		LaunchSpecID: spotinst.String(launchSpecId),
@@ -195,8 +282,30 @@ func deleteECSLaunchSpec(resourceData *schema.ResourceData, meta interface{}) er
 		log.Printf("===> launchSpec delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteECSLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteECSLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete launchSpecId: %s", err)
 	}
 	return nil
 }
+
+func expandOceanECSLaunchSpecRollConfig(data interface{}, clusterID, specID string) (*aws.ECSRoll, error) {
+	list := data.([]interface{})
+	spec := &aws.ECSRoll{
+		ClusterID:     spotinst.String(clusterID),
+		LaunchSpecIDs: []string{specID},
+	}
+
+	if len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(ocean_ecs_launch_spec.BatchSizePercentage)].(int); ok {
+			spec.BatchSizePercentage = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(ocean_ecs_launch_spec.BatchMinHealthyPercentage)].(int); ok && v > 0 {
+			spec.BatchMinHealthyPercentage = spotinst.Int(v)
+		}
+	}
+
+	return spec, nil
+}
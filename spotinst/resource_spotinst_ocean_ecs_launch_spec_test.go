@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -37,7 +36,7 @@ func testSweepOceanECSLaunchSpec(region string) error {
 			log.Printf("[INFO] No launch specs to sweep")
 		}
 		for _, launchSpec := range resp.LaunchSpecs {
-			if strings.Contains(spotinst.StringValue(launchSpec.Name), "test-acc-") {
+			if isSweepable(launchSpec.Name) {
 				if _, err := conn.DeleteECSLaunchSpec(context.Background(), &aws.DeleteECSLaunchSpecInput{LaunchSpecID: launchSpec.ID}); err != nil {
 					return fmt.Errorf("unable to delete launch spec %v in sweep", spotinst.StringValue(launchSpec.ID))
 				} else {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,8 +15,9 @@ import (
 
 func init() {
 	resource.AddTestSweepers("spotinst_ocean_ecs", &resource.Sweeper{
-		Name: "spotinst_ocean_ecs",
-		F:    testSweepOceanECS,
+		Name:         "spotinst_ocean_ecs",
+		F:            testSweepOceanECS,
+		Dependencies: []string{"spotinst_ocean_ecs_launch_spec"},
 	})
 }
 
@@ -36,7 +36,7 @@ func testSweepOceanECS(region string) error {
 			log.Printf("[INFO] No clusters to sweep")
 		}
 		for _, cluster := range resp.Clusters {
-			if strings.Contains(spotinst.StringValue(cluster.Name), "test-acc-") {
+			if isSweepable(cluster.Name) {
 				if _, err := conn.DeleteECSCluster(context.Background(), &aws.DeleteECSClusterInput{ClusterID: cluster.ID}); err != nil {
 					return fmt.Errorf("unable to delete cluster %v in sweep", spotinst.StringValue(cluster.ID))
 				} else {
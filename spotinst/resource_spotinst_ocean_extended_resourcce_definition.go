@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,6 +28,8 @@ func resourceSpotinstOceanAWSExtendedResourceDefinition() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.OceanAWSExtendedResourceDefinitionResource.GetTimeouts(),
+
 		Schema: commons.OceanAWSExtendedResourceDefinitionResource.GetSchemaMap(),
 	}
 }
@@ -47,7 +48,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionRead(ctx context.Context,
 	log.Printf(string(commons.ResourceOnRead), commons.OceanAWSExtendedResourceDefinitionResource.GetName(), resourceId)
 
 	input := &aws.ReadExtendedResourceDefinitionInput{ExtendedResourceDefinitionID: spotinst.String(resourceId)}
-	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadExtendedResourceDefinition(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadExtendedResourceDefinition(ctx, input)
 	if err != nil {
 		// If the ExtendedResourceDefinition was not found, return nil so that we can show
 		// that the ExtendedResourceDefinition does not exist
@@ -84,7 +85,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionCreate(ctx context.Contex
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	extendedResourceDefinitionId, err := createOceanAWSExtendedResourceDefinition(resourceData, extendedResourceDefinition, meta.(*Client))
+	extendedResourceDefinitionId, err := createOceanAWSExtendedResourceDefinition(ctx, resourceData, extendedResourceDefinition, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -97,16 +98,16 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionCreate(ctx context.Contex
 
 }
 
-func createOceanAWSExtendedResourceDefinition(resourceData *schema.ResourceData, erd *aws.ExtendedResourceDefinition, spotinstClient *Client) (*string, error) {
+func createOceanAWSExtendedResourceDefinition(ctx context.Context, resourceData *schema.ResourceData, erd *aws.ExtendedResourceDefinition, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(erd); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> ExtendedResourceDefinition create configuration: %s", json)
 	}
 	var resp *aws.CreateExtendedResourceDefinitionOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateExtendedResourceDefinitionInput{ExtendedResourceDefinition: erd}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateExtendedResourceDefinition(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateExtendedResourceDefinition(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -133,7 +134,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionUpdate(ctx context.Contex
 
 	if shouldUpdate {
 		erd.SetId(spotinst.String(resourceId))
-		if err := updateOceanAWSExtendedResourceDefinition(erd, resourceData, meta); err != nil {
+		if err := updateOceanAWSExtendedResourceDefinition(ctx, erd, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -141,7 +142,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionUpdate(ctx context.Contex
 	return resourceSpotinstOceanAWSExtendedResourceDefinitionRead(ctx, resourceData, meta)
 }
 
-func updateOceanAWSExtendedResourceDefinition(erd *aws.ExtendedResourceDefinition, resourceData *schema.ResourceData, meta interface{}) error {
+func updateOceanAWSExtendedResourceDefinition(ctx context.Context, erd *aws.ExtendedResourceDefinition, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &aws.UpdateExtendedResourceDefinitionInput{
 		ExtendedResourceDefinition: erd,
 	}
@@ -153,7 +154,7 @@ func updateOceanAWSExtendedResourceDefinition(erd *aws.ExtendedResourceDefinitio
 		log.Printf("===> ExtendedResourceDefinition update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateExtendedResourceDefinition(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateExtendedResourceDefinition(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update ExtendedResourceDefinition [%v]: %v", erdId, err)
 	}
 	return nil
@@ -163,7 +164,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionDelete(ctx context.Contex
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.OceanAWSExtendedResourceDefinitionResource.GetName(), resourceId)
 
-	if err := deleteOceanAWSExtendedResourceDefinition(resourceData, meta); err != nil {
+	if err := deleteOceanAWSExtendedResourceDefinition(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -172,7 +173,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionDelete(ctx context.Contex
 	return nil
 }
 
-func deleteOceanAWSExtendedResourceDefinition(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteOceanAWSExtendedResourceDefinition(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	erdId := resourceData.Id()
 	input := &aws.DeleteExtendedResourceDefinitionInput{
 		ExtendedResourceDefinitionID: spotinst.String(erdId),
@@ -183,7 +184,7 @@ func deleteOceanAWSExtendedResourceDefinition(resourceData *schema.ResourceData,
 		log.Printf("===> ExtendedResourceDefinition delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteExtendedResourceDefinition(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteExtendedResourceDefinition(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete ExtendedResourceDefinition: %s", err)
 	}
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"path"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,6 +14,42 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_ocean_aws_extended_resource_definition", &resource.Sweeper{
+		Name: "spotinst_ocean_aws_extended_resource_definition",
+		F:    testSweepOceanAWSExtendedResourceDefinition,
+	})
+}
+
+func testSweepOceanAWSExtendedResourceDefinition(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).ocean.CloudProviderAWS()
+	input := &aws.ListExtendedResourceDefinitionsInput{}
+	if resp, err := conn.ListExtendedResourceDefinition(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of extended resource definitions to sweep")
+	} else {
+		if len(resp.ExtendedResourceDefinitions) == 0 {
+			log.Printf("[INFO] No extended resource definitions to sweep")
+		}
+		for _, erd := range resp.ExtendedResourceDefinitions {
+			// Extended resource names are qualified by a domain, e.g.
+			// example.com/test-acc-baseline.
+			if isSweepable(spotinst.String(path.Base(spotinst.StringValue(erd.Name)))) {
+				if _, err := conn.DeleteExtendedResourceDefinition(context.Background(), &aws.DeleteExtendedResourceDefinitionInput{ExtendedResourceDefinitionID: erd.ID}); err != nil {
+					return fmt.Errorf("unable to delete extended resource definition %v in sweep", spotinst.StringValue(erd.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(erd.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createOceanAWSExtendedResourceDefinitionResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.OceanAWSExtendedResourceDefinitionResourceName), name)
 }
@@ -123,7 +160,7 @@ func TestAccSpotinstOceanAWSExtendedResourceDefinition_Baseline(t *testing.T) {
 
 				Check: resource.ComposeTestCheckFunc(
 					testCheckOceanAWSExtendedResourceDefinitionExists(&erd, resourceName),
-					resource.TestCheckResourceAttr(resourceName, "name", "example.com/terraform-test-baseline"),
+					resource.TestCheckResourceAttr(resourceName, "name", "example.com/test-acc-baseline"),
 					resource.TestCheckResourceAttr(resourceName, "resource_mapping.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "resource_mapping.c3.large", "2Ki"),
 				),
@@ -135,7 +172,7 @@ func TestAccSpotinstOceanAWSExtendedResourceDefinition_Baseline(t *testing.T) {
 					updateBaselineFields: true}, testBaselineExtendedResourceDefinitionConfig_Update),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckOceanAWSExtendedResourceDefinitionExists(&erd, resourceName),
-					resource.TestCheckResourceAttr(resourceName, "name", "example.com/terraform-test-baseline"),
+					resource.TestCheckResourceAttr(resourceName, "name", "example.com/test-acc-baseline"),
 					resource.TestCheckResourceAttr(resourceName, "resource_mapping.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "resource_mapping.c3.large", "2Ki"),
 					resource.TestCheckResourceAttr(resourceName, "resource_mapping.c3.xlarge", "4Ki"),
@@ -148,7 +185,7 @@ func TestAccSpotinstOceanAWSExtendedResourceDefinition_Baseline(t *testing.T) {
 const testBaselineExtendedResourceDefinitionConfig_Create = `
 resource "` + string(commons.OceanAWSExtendedResourceDefinitionResourceName) + `" "%v" {
   provider = "%v"
-  name  = "example.com/terraform-test-baseline"
+  name  = "example.com/test-acc-baseline"
   resource_mapping = {
     "c3.large"  = "2Ki"
   }
@@ -158,7 +195,7 @@ resource "` + string(commons.OceanAWSExtendedResourceDefinitionResourceName) + `
 const testBaselineExtendedResourceDefinitionConfig_Update = `
 resource "` + string(commons.OceanAWSExtendedResourceDefinitionResourceName) + `" "%v" {
   provider = "%v"
-  name  = "example.com/terraform-test-baseline"
+  name  = "example.com/test-acc-baseline"
   resource_mapping = {
     "c3.large"  = "2Ki"
     "c3.xlarge" = "4Ki"
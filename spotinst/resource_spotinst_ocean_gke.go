@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,6 +31,11 @@ func resourceSpotinstOceanGKE() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		Timeouts: commons.OceanGKEResource.GetTimeouts(),
+
+		CustomizeDiff: commons.OceanGKEResource.OnDiff,
+
 		Schema: commons.OceanGKEResource.GetSchemaMap(),
 	}
 }
@@ -57,18 +61,18 @@ func resourceSpotinstClusterGKECreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createGKECluster(cluster, meta.(*Client))
+	clusterID, err := createGKECluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(clusterID))
 
-	log.Printf("===> Elastigroup created successfully: %s <===", resourceData.Id())
+	log.Printf("===> Cluster created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstClusterGKERead(ctx, resourceData, meta)
 }
 
-func createGKECluster(cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
+func createGKECluster(ctx context.Context, resourceData *schema.ResourceData, cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -76,9 +80,9 @@ func createGKECluster(cluster *gcp.Cluster, spotinstClient *Client) (*string, er
 	}
 
 	var resp *gcp.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -100,7 +104,7 @@ func resourceSpotinstClusterGKERead(ctx context.Context, resourceData *schema.Re
 		commons.OceanGKEResource.GetName(), id)
 
 	input := &gcp.ReadClusterInput{ClusterID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadCluster(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadCluster(ctx, input)
 
 	if err != nil {
 		// If the cluster was not found, return nil so that we can show
@@ -137,14 +141,14 @@ func resourceSpotinstClusterGKEUpdate(ctx context.Context, resourceData *schema.
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.OceanGKEResource.GetName(), id)
 
-	shouldUpdate, cluster, err := commons.OceanGKEResource.OnUpdate(resourceData, meta)
+	shouldUpdate, changesRequiredRoll, cluster, err := commons.OceanGKEResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		cluster.SetId(spotinst.String(id))
-		if err := updateGKECluster(cluster, resourceData, meta); err != nil {
+		if err := updateGKECluster(ctx, cluster, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -152,12 +156,28 @@ func resourceSpotinstClusterGKEUpdate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterGKERead(ctx, resourceData, meta)
 }
 
-func updateGKECluster(cluster *gcp.Cluster, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGKECluster(ctx context.Context, cluster *gcp.Cluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &gcp.UpdateClusterInput{
 		Cluster: cluster,
 	}
 
+	var shouldRoll = false
+	var conditionedRoll = false
 	clusterID := resourceData.Id()
+	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_gke.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+
+			if roll, ok := m[string(ocean_gke.ShouldRoll)].(bool); ok && roll {
+				shouldRoll = roll
+			}
+
+			if condRoll, ok := m[string(ocean_gke.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
+		}
+	}
 
 	if json, err := commons.ToJson(cluster); err != nil {
 		return err
@@ -165,8 +185,19 @@ func updateGKECluster(cluster *gcp.Cluster, resourceData *schema.ResourceData, m
 		log.Printf("===> Cluster update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update cluster [%v]: %v", clusterID, err)
+	} else if shouldRoll {
+		if !conditionedRoll || changesRequiredRoll {
+			// The update_policy block of spotinst_ocean_gke has the same schema as
+			// the one of spotinst_ocean_gke_import, so the roll logic is shared.
+			if err := rollOceanGKECluster(ctx, resourceData, meta); err != nil {
+				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", clusterID, err)
+				return err
+			}
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_gke.ShouldRoll))
 	}
 
 	return nil
@@ -177,7 +208,7 @@ func resourceSpotinstClusterGKEDelete(ctx context.Context, resourceData *schema.
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanGKEResource.GetName(), id)
 
-	if err := deleteGKECluster(resourceData, meta); err != nil {
+	if err := deleteGKECluster(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -186,7 +217,7 @@ func resourceSpotinstClusterGKEDelete(ctx context.Context, resourceData *schema.
 	return nil
 }
 
-func deleteGKECluster(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGKECluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	clusterID := resourceData.Id()
 	input := &gcp.DeleteClusterInput{
 		ClusterID: spotinst.String(clusterID),
@@ -198,7 +229,7 @@ func deleteGKECluster(resourceData *schema.ResourceData, meta interface{}) error
 		log.Printf("===> Cluster delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete cluster: %s", err)
 	}
 	return nil
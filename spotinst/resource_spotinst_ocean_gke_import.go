@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,8 +29,15 @@ func resourceSpotinstOceanGKEImport() *schema.Resource {
 		DeleteContext: resourceSpotinstClusterGKEImportDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importWithDefaults(
+				commons.RollImportDefaults(ocean_gke_import.RollRequired, ocean_gke_import.RollRequiredFields),
+				schema.ImportStatePassthroughContext),
 		},
+
+		Timeouts: commons.OceanGKEImportResource.GetTimeouts(),
+
+		CustomizeDiff: commons.OceanGKEImportResource.OnDiff,
+
 		Schema: commons.OceanGKEImportResource.GetSchemaMap(),
 	}
 }
@@ -48,13 +54,13 @@ func setupClusterGKEImportResource() {
 	commons.OceanGKEImportResource = commons.NewOceanGKEImportResource(fieldsMap)
 }
 
-func importOceanGKECluster(resourceData *schema.ResourceData, meta interface{}) (*gcp.Cluster, error) {
+func importOceanGKECluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) (*gcp.Cluster, error) {
 	input := &gcp.ImportOceanGKEClusterInput{
 		ClusterName: spotinst.String(resourceData.Get("cluster_name").(string)),
 		Location:    spotinst.String(resourceData.Get("location").(string)),
 	}
 
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ImportOceanGKECluster(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ImportOceanGKECluster(ctx, input)
 
 	if err != nil {
 		// If the group was not found, return nil so that we can show
@@ -78,7 +84,7 @@ func resourceSpotinstClusterGKEImportCreate(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.OceanGKEImportResource.GetName())
 
-	importedCluster, err := importOceanGKECluster(resourceData, meta.(*Client))
+	importedCluster, err := importOceanGKECluster(ctx, resourceData, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -88,7 +94,7 @@ func resourceSpotinstClusterGKEImportCreate(ctx context.Context, resourceData *s
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createGKEImportedCluster(cluster, meta.(*Client))
+	clusterID, err := createGKEImportedCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -99,7 +105,7 @@ func resourceSpotinstClusterGKEImportCreate(ctx context.Context, resourceData *s
 	return resourceSpotinstClusterGKEImportRead(ctx, resourceData, meta)
 }
 
-func createGKEImportedCluster(cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
+func createGKEImportedCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -107,9 +113,9 @@ func createGKEImportedCluster(cluster *gcp.Cluster, spotinstClient *Client) (*st
 	}
 
 	var resp *gcp.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -131,7 +137,7 @@ func resourceSpotinstClusterGKEImportRead(ctx context.Context, resourceData *sch
 		commons.OceanGKEImportResource.GetName(), id)
 
 	input := &gcp.ReadClusterInput{ClusterID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadCluster(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadCluster(ctx, input)
 
 	if err != nil {
 		// If the cluster was not found, return nil so that we can show
@@ -187,7 +193,7 @@ func resourceSpotinstClusterGKEImportUpdate(ctx context.Context, resourceData *s
 
 	if shouldUpdate {
 		cluster.SetId(spotinst.String(id))
-		if err := updateGKEImportCluster(cluster, resourceData, meta, changesRequiredRoll); err != nil {
+		if err := updateGKEImportCluster(ctx, cluster, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -195,7 +201,7 @@ func resourceSpotinstClusterGKEImportUpdate(ctx context.Context, resourceData *s
 	return resourceSpotinstClusterGKEImportRead(ctx, resourceData, meta)
 }
 
-func updateGKEImportCluster(cluster *gcp.Cluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
+func updateGKEImportCluster(ctx context.Context, cluster *gcp.Cluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &gcp.UpdateClusterInput{
 		Cluster: cluster,
 	}
@@ -224,11 +230,11 @@ func updateGKEImportCluster(cluster *gcp.Cluster, resourceData *schema.ResourceD
 		log.Printf("===> GKE Cluster update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update GKE cluster [%v]: %v", clusterID, err)
 	} else if shouldRoll {
 		if !conditionedRoll || changesRequiredRoll {
-			if err := rollOceanGKECluster(resourceData, meta); err != nil {
+			if err := rollOceanGKECluster(ctx, resourceData, meta); err != nil {
 				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", clusterID, err)
 				return err
 			}
@@ -245,7 +251,7 @@ func resourceSpotinstClusterGKEImportDelete(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanGKEImportResource.GetName(), id)
 
-	if err := deleteGKEImportCluster(resourceData, meta); err != nil {
+	if err := deleteGKEImportCluster(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -254,7 +260,7 @@ func resourceSpotinstClusterGKEImportDelete(ctx context.Context, resourceData *s
 	return nil
 }
 
-func deleteGKEImportCluster(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGKEImportCluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	clusterID := resourceData.Id()
 	input := &gcp.DeleteClusterInput{
 		ClusterID: spotinst.String(clusterID),
@@ -266,13 +272,13 @@ func deleteGKEImportCluster(resourceData *schema.ResourceData, meta interface{})
 		log.Printf("===> GKE Cluster delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteCluster(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteCluster(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete GKE cluster: %s", err)
 	}
 	return nil
 }
 
-func rollOceanGKECluster(resourceData *schema.ResourceData, meta interface{}) error {
+func rollOceanGKECluster(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	clusterID := resourceData.Id()
 
 	updatePolicy, exists := resourceData.GetOkExists(string(ocean_gke_import.UpdatePolicy))
@@ -304,7 +310,7 @@ func rollOceanGKECluster(resourceData *schema.ResourceData, meta interface{}) er
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &gcp.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderGCP().CreateRoll(context.TODO(), rollInput); err != nil {
+		if _, err = meta.(*Client).ocean.CloudProviderGCP().CreateRoll(ctx, rollInput); err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
@@ -319,14 +325,14 @@ func expandOceanGKEClusterRollConfig(data interface{}, clusterID string) (*gcp.R
 		ClusterID: spotinst.String(clusterID),
 	}
 
-	if list != nil && list[0] != nil {
+	if len(list) > 0 && list[0] != nil {
 		m := list[0].(map[string]interface{})
 
 		if v, ok := m[string(ocean_gke_import.BatchSizePercentage)].(int); ok {
 			spec.BatchSizePercentage = spotinst.Int(v)
 		}
 
-		if v, ok := m[string(ocean_gke_import.LaunchSpecIDs)].([]string); ok {
+		if v, ok := m[string(ocean_gke_import.LaunchSpecIDs)].([]interface{}); ok {
 			spec.LaunchSpecIDs = expandOceanGKELaunchSpecIDs(v)
 		}
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -17,9 +16,10 @@ import (
 var GcpClusterName = "terraform-tests-do-not-delete"
 
 func init() {
-	resource.AddTestSweepers("resource_spotinst_ocean_gke_import", &resource.Sweeper{
-		Name: "resource_spotinst_ocean_gke_import",
-		F:    testSweepOceanGKEImportCluster,
+	resource.AddTestSweepers("spotinst_ocean_gke_import", &resource.Sweeper{
+		Name:         "spotinst_ocean_gke_import",
+		F:            testSweepOceanGKEImportCluster,
+		Dependencies: []string{"spotinst_ocean_gke_launch_spec_import"},
 	})
 }
 
@@ -39,7 +39,7 @@ func testSweepOceanGKEImportCluster(region string) error {
 			log.Printf("[INFO] No clusters to sweep")
 		}
 		for _, cluster := range resp.Clusters {
-			if strings.Contains(spotinst.StringValue(cluster.Name), "terraform-acc-tests-") {
+			if isSweepable(cluster.Name) {
 				if _, err := conn.DeleteCluster(context.Background(), &gcp.DeleteClusterInput{ClusterID: cluster.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(cluster.ID))
 				} else {
@@ -29,6 +29,8 @@ func resourceSpotinstOceanGKELaunchSpec() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.OceanGKELaunchSpecResource.GetTimeouts(),
+
 		Schema: commons.OceanGKELaunchSpecResource.GetSchemaMap(),
 	}
 }
@@ -50,7 +52,7 @@ func resourceSpotinstOceanGKELaunchSpecCreate(ctx context.Context, resourceData
 	var err error
 
 	if v, ok := resourceData.Get(string(ocean_gke_launch_spec.NodePoolName)).(string); ok && v != "" {
-		importedLaunchSpec, err = importGKELaunchSpec(resourceData, meta)
+		importedLaunchSpec, err = importGKELaunchSpec(ctx, resourceData, meta)
 
 		if err != nil {
 			return diag.FromErr(err)
@@ -64,7 +66,7 @@ func resourceSpotinstOceanGKELaunchSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createGKELaunchSpec(launchSpec, meta.(*Client))
+	launchSpecId, err := createGKELaunchSpec(ctx, launchSpec, meta.(*Client))
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -75,7 +77,7 @@ func resourceSpotinstOceanGKELaunchSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanGKELaunchSpecRead(ctx, resourceData, meta)
 }
 
-func createGKELaunchSpec(launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*string, error) {
+func createGKELaunchSpec(ctx context.Context, launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -84,7 +86,7 @@ func createGKELaunchSpec(launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*s
 
 	input := &gcp.CreateLaunchSpecInput{LaunchSpec: launchSpec}
 
-	if out, err := spotinstClient.ocean.CloudProviderGCP().CreateLaunchSpec(context.Background(), input); err != nil {
+	if out, err := spotinstClient.ocean.CloudProviderGCP().CreateLaunchSpec(ctx, input); err != nil {
 		return nil, fmt.Errorf("[ERROR] failed to create launchSpec: %s", err)
 	} else {
 		return out.LaunchSpec.ID, nil
@@ -98,7 +100,7 @@ func resourceSpotinstOceanGKELaunchSpecRead(ctx context.Context, resourceData *s
 	log.Printf(string(commons.ResourceOnRead), commons.OceanGKELaunchSpecResource.GetName(), id)
 
 	input := &gcp.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadLaunchSpec(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadLaunchSpec(ctx, input)
 
 	if err != nil {
 		// If the launchSpec was not found, return nil so that we can show
@@ -134,14 +136,14 @@ func resourceSpotinstOceanGKELaunchSpecUpdate(ctx context.Context, resourceData
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanGKELaunchSpecResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanGKELaunchSpecResource.OnUpdate(resourceData, meta)
+	shouldUpdate, changesRequiredRoll, launchSpec, err := commons.OceanGKELaunchSpecResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		launchSpec.SetId(spotinst.String(id))
-		if err := updateGKELaunchSpec(launchSpec, resourceData, meta); err != nil {
+		if err := updateGKELaunchSpec(ctx, launchSpec, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -149,7 +151,7 @@ func resourceSpotinstOceanGKELaunchSpecUpdate(ctx context.Context, resourceData
 	return resourceSpotinstOceanGKELaunchSpecRead(ctx, resourceData, meta)
 }
 
-func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGKELaunchSpec(ctx context.Context, launchSpec *gcp.LaunchSpec, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &gcp.UpdateLaunchSpecInput{
 		LaunchSpec: launchSpec,
 	}
@@ -158,6 +160,7 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 
 	oceanId := resourceData.Get(string(ocean_gke_launch_spec.OceanId))
 	var shouldRoll = false
+	var conditionedRoll = false
 	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_gke_launch_spec.UpdatePolicy)); exists {
 		list := updatePolicy.([]interface{})
 		if len(list) > 0 && list[0] != nil {
@@ -166,6 +169,10 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 			if roll, ok := m[string(ocean_gke_launch_spec.ShouldRoll)].(bool); ok && roll {
 				shouldRoll = roll
 			}
+
+			if condRoll, ok := m[string(ocean_gke_launch_spec.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
 		}
 	}
 
@@ -175,12 +182,16 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 		log.Printf("===> launchSpec GKE update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec GKE [%v]: %v", launchSpecId, err)
 	} else if shouldRoll {
-		if err := rollOceanGKELaunchSpec(resourceData, meta); err != nil {
-			log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
-			return err
+		if !conditionedRoll || changesRequiredRoll {
+			if err := rollOceanGKELaunchSpec(ctx, resourceData, meta); err != nil {
+				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
+				return err
+			}
+		} else {
+			log.Printf("onRoll() -> No field requiring a roll changed, skipping roll of launchSpec GKE [%v]", launchSpecId)
 		}
 	} else {
 		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_gke_launch_spec.ShouldRoll))
@@ -189,7 +200,7 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 	return nil
 }
 
-func rollOceanGKELaunchSpec(resourceData *schema.ResourceData, meta interface{}) error {
+func rollOceanGKELaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	specID := resourceData.Id()
 	clusterID := resourceData.Get(string(ocean_gke_launch_spec.OceanId)).(string)
 
@@ -222,7 +233,7 @@ func rollOceanGKELaunchSpec(resourceData *schema.ResourceData, meta interface{})
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &gcp.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderGCP().CreateRoll(context.TODO(), rollInput); err != nil {
+		if _, err = meta.(*Client).ocean.CloudProviderGCP().CreateRoll(ctx, rollInput); err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
@@ -236,7 +247,7 @@ func resourceSpotinstOceanGKELaunchSpecDelete(ctx context.Context, resourceData
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanGKELaunchSpecResource.GetName(), id)
 
-	if err := deleteGKELaunchSpec(resourceData, meta); err != nil {
+	if err := deleteGKELaunchSpec(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -245,7 +256,7 @@ func resourceSpotinstOceanGKELaunchSpecDelete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteGKELaunchSpec(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGKELaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	launchSpecId := resourceData.Id()
 	input := &gcp.DeleteLaunchSpecInput{
 		LaunchSpecID: spotinst.String(launchSpecId),
@@ -257,20 +268,20 @@ func deleteGKELaunchSpec(resourceData *schema.ResourceData, meta interface{}) er
 		log.Printf("===> launchSpec GKE delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete launchSpecId: %s", err)
 	}
 	return nil
 }
 
 //region Import Ocean GKE Launch Spec
-func importGKELaunchSpec(resourceData *schema.ResourceData, meta interface{}) (*gcp.LaunchSpec, error) {
+func importGKELaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) (*gcp.LaunchSpec, error) {
 	input := &gcp.ImportOceanGKELaunchSpecInput{
 		OceanId:      spotinst.String(resourceData.Get("ocean_id").(string)),
 		NodePoolName: spotinst.String(resourceData.Get("node_pool_name").(string)),
 	}
 
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ImportOceanGKELaunchSpec(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ImportOceanGKELaunchSpec(ctx, input)
 
 	if err != nil {
 		// If the group was not found, return nil so that we can show
@@ -27,6 +27,8 @@ func resourceSpotinstOceanGKELaunchSpecImport() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.OceanGKELaunchSpecImportResource.GetTimeouts(),
+
 		Schema: commons.OceanGKELaunchSpecImportResource.GetSchemaMap(),
 	}
 }
@@ -42,7 +44,7 @@ func setupOceanGKELaunchSpecImportResource() {
 func resourceSpotinstOceanGKELaunchSpecImportCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf(string(commons.ResourceOnCreate), commons.OceanGKELaunchSpecImportResource.GetName())
 
-	importedLaunchSpec, err := importOceanGKELaunchSpec(resourceData, meta)
+	importedLaunchSpec, err := importOceanGKELaunchSpec(ctx, resourceData, meta)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -54,7 +56,7 @@ func resourceSpotinstOceanGKELaunchSpecImportCreate(ctx context.Context, resourc
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createGKELaunchSpecImport(launchSpec, meta.(*Client))
+	launchSpecId, err := createGKELaunchSpecImport(ctx, launchSpec, meta.(*Client))
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -65,7 +67,7 @@ func resourceSpotinstOceanGKELaunchSpecImportCreate(ctx context.Context, resourc
 	return resourceSpotinstOceanGKELaunchSpecImportRead(ctx, resourceData, meta)
 }
 
-func createGKELaunchSpecImport(launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*string, error) {
+func createGKELaunchSpecImport(ctx context.Context, launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -74,7 +76,7 @@ func createGKELaunchSpecImport(launchSpec *gcp.LaunchSpec, spotinstClient *Clien
 
 	input := &gcp.CreateLaunchSpecInput{LaunchSpec: launchSpec}
 
-	if out, err := spotinstClient.ocean.CloudProviderGCP().CreateLaunchSpec(context.Background(), input); err != nil {
+	if out, err := spotinstClient.ocean.CloudProviderGCP().CreateLaunchSpec(ctx, input); err != nil {
 		return nil, fmt.Errorf("[ERROR] failed to create launchSpec: %s", err)
 	} else {
 		return out.LaunchSpec.ID, nil
@@ -86,7 +88,7 @@ func resourceSpotinstOceanGKELaunchSpecImportRead(ctx context.Context, resourceD
 	log.Printf(string(commons.ResourceOnRead), commons.OceanGKELaunchSpecImportResource.GetName(), id)
 
 	input := &gcp.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(id)}
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadLaunchSpec(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ReadLaunchSpec(ctx, input)
 
 	if err != nil {
 		// If the launchSpec was not found, return nil so that we can show
@@ -129,7 +131,7 @@ func resourceSpotinstOceanGKELaunchSpecImportUpdate(ctx context.Context, resourc
 
 	if shouldUpdate {
 		launchSpec.SetId(spotinst.String(id))
-		if err := updateGKELaunchSpecImport(launchSpec, resourceData, meta); err != nil {
+		if err := updateGKELaunchSpecImport(ctx, launchSpec, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -137,7 +139,7 @@ func resourceSpotinstOceanGKELaunchSpecImportUpdate(ctx context.Context, resourc
 	return resourceSpotinstOceanGKELaunchSpecImportRead(ctx, resourceData, meta)
 }
 
-func updateGKELaunchSpecImport(launchSpec *gcp.LaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGKELaunchSpecImport(ctx context.Context, launchSpec *gcp.LaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &gcp.UpdateLaunchSpecInput{
 		LaunchSpec: launchSpec,
 	}
@@ -150,7 +152,7 @@ func updateGKELaunchSpecImport(launchSpec *gcp.LaunchSpec, resourceData *schema.
 		log.Printf("===> launchSpec GKE update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec GKE [%v]: %v", launchSpecId, err)
 	}
 
@@ -162,7 +164,7 @@ func resourceSpotinstOceanGKELaunchSpecImportDelete(ctx context.Context, resourc
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.OceanGKELaunchSpecImportResource.GetName(), id)
 
-	if err := deleteGKELaunchSpecImport(resourceData, meta); err != nil {
+	if err := deleteGKELaunchSpecImport(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -171,7 +173,7 @@ func resourceSpotinstOceanGKELaunchSpecImportDelete(ctx context.Context, resourc
 	return nil
 }
 
-func deleteGKELaunchSpecImport(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGKELaunchSpecImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	launchSpecId := resourceData.Id()
 	input := &gcp.DeleteLaunchSpecInput{
 		LaunchSpecID: spotinst.String(launchSpecId),
@@ -183,20 +185,20 @@ func deleteGKELaunchSpecImport(resourceData *schema.ResourceData, meta interface
 		log.Printf("===> launchSpec GKE delete configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteLaunchSpec(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).ocean.CloudProviderGCP().DeleteLaunchSpec(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete launchSpecId: %s", err)
 	}
 	return nil
 }
 
 //region Import Ocean GKE Launch Spec
-func importOceanGKELaunchSpec(resourceData *schema.ResourceData, meta interface{}) (*gcp.LaunchSpec, error) {
+func importOceanGKELaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) (*gcp.LaunchSpec, error) {
 	input := &gcp.ImportOceanGKELaunchSpecInput{
 		OceanId:      spotinst.String(resourceData.Get("ocean_id").(string)),
 		NodePoolName: spotinst.String(resourceData.Get("node_pool_name").(string)),
 	}
 
-	resp, err := meta.(*Client).ocean.CloudProviderGCP().ImportOceanGKELaunchSpec(context.Background(), input)
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ImportOceanGKELaunchSpec(ctx, input)
 
 	if err != nil {
 		// If the group was not found, return nil so that we can show
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,8 +14,8 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("resource_spotinst_ocean_gke_launch_spec_import", &resource.Sweeper{
-		Name: "resource_spotinst_ocean_gke_import",
+	resource.AddTestSweepers("spotinst_ocean_gke_launch_spec_import", &resource.Sweeper{
+		Name: "spotinst_ocean_gke_launch_spec_import",
 		F:    testSweepOceanGKELaunchSpecImport,
 	})
 }
@@ -38,7 +37,7 @@ func testSweepOceanGKELaunchSpecImport(region string) error {
 		}
 
 		for _, launchSpec := range resp.LaunchSpecs {
-			if strings.Contains(spotinst.StringValue(launchSpec.ID), "terraform-acc-tests-") {
+			if isSweepable(launchSpec.Name) {
 				if _, err := conn.DeleteLaunchSpec(context.Background(), &gcp.DeleteLaunchSpecInput{LaunchSpecID: launchSpec.ID}); err != nil {
 					return fmt.Errorf("unable to delete group %v in sweep", spotinst.StringValue(launchSpec.ID))
 				} else {
@@ -13,39 +13,39 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
-//func init() {
-//	resource.AddTestSweepers("spotinst_ocean_gke_launch_spec", &resource.Sweeper{
-//		Name: "spotinst_ocean_gke_launch_spec",
-//		F:    testSweepOceanGKELaunchSpec,
-//	})
-//}
-//
-//func testSweepOceanGKELaunchSpec(region string) error {
-//	client, err := getProviderClient("gcp")
-//	if err != nil {
-//		return fmt.Errorf("error getting client: %v", err)
-//	}
-//
-//	conn := client.(*Client).ocean.CloudProviderGCP()
-//	input := &gcp.ListLaunchSpecsInput{}
-//	if resp, err := conn.ListLaunchSpecs(context.Background(), input); err != nil {
-//		return fmt.Errorf("error getting list of launch specs to sweep")
-//	} else {
-//		if len(resp.LaunchSpecs) == 0 {
-//			log.Printf("[INFO] No launch specs to sweep")
-//		}
-//		for _, launchSpec := range resp.LaunchSpecs {
-//			if strings.Contains(spotinst.StringValue(launchSpec.<WHAT>), "test-acc-") {
-//				if _, err := conn.DeleteLaunchSpec(context.Background(), &gcp.DeleteLaunchSpecInput{LaunchSpecID: launchSpec.ID}); err != nil {
-//					return fmt.Errorf("unable to delete launch spec %v in sweep", spotinst.StringValue(launchSpec.ID))
-//				} else {
-//					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(launchSpec.ID))
-//				}
-//			}
-//		}
-//	}
-//	return nil
-//}
+func init() {
+	resource.AddTestSweepers("spotinst_ocean_gke_launch_spec", &resource.Sweeper{
+		Name: "spotinst_ocean_gke_launch_spec",
+		F:    testSweepOceanGKELaunchSpec,
+	})
+}
+
+func testSweepOceanGKELaunchSpec(region string) error {
+	client, err := getProviderClient("gcp")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).ocean.CloudProviderGCP()
+	input := &gcp.ListLaunchSpecsInput{}
+	if resp, err := conn.ListLaunchSpecs(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of launch specs to sweep")
+	} else {
+		if len(resp.LaunchSpecs) == 0 {
+			log.Printf("[INFO] No launch specs to sweep")
+		}
+		for _, launchSpec := range resp.LaunchSpecs {
+			if isSweepable(launchSpec.Name) {
+				if _, err := conn.DeleteLaunchSpec(context.Background(), &gcp.DeleteLaunchSpecInput{LaunchSpecID: launchSpec.ID}); err != nil {
+					return fmt.Errorf("unable to delete launch spec %v in sweep", spotinst.StringValue(launchSpec.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(launchSpec.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
 
 func createOceanGKELaunchSpecResource(oceanID string) string {
 	return fmt.Sprintf("%v.%v", string(commons.OceanGKELaunchSpecResourceName), oceanID)
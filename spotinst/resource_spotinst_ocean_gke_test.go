@@ -0,0 +1,324 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+func init() {
+	resource.AddTestSweepers("spotinst_ocean_gke", &resource.Sweeper{
+		Name:         "spotinst_ocean_gke",
+		F:            testSweepOceanGKECluster,
+		Dependencies: []string{"spotinst_ocean_gke_launch_spec"},
+	})
+}
+
+func testSweepOceanGKECluster(region string) error {
+	client, err := getProviderClient("gcp")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).ocean.CloudProviderGCP()
+
+	input := &gcp.ListClustersInput{}
+	if resp, err := conn.ListClusters(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of clusters to sweep")
+	} else {
+		if len(resp.Clusters) == 0 {
+			log.Printf("[INFO] No clusters to sweep")
+		}
+		for _, cluster := range resp.Clusters {
+			if isSweepable(cluster.Name) {
+				if _, err := conn.DeleteCluster(context.Background(), &gcp.DeleteClusterInput{ClusterID: cluster.ID}); err != nil {
+					return fmt.Errorf("unable to delete cluster %v in sweep", spotinst.StringValue(cluster.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(cluster.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func createOceanGKEResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanGKEResourceName), name)
+}
+
+func testOceanGKEDestroy(s *terraform.State) error {
+	client := testAccProviderGCP.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanGKEResourceName) {
+			continue
+		}
+		input := &gcp.ReadClusterInput{ClusterID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderGCP().ReadCluster(context.Background(), input)
+		if err == nil && resp != nil && resp.Cluster != nil {
+			return fmt.Errorf("cluster still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckOceanGKEAttributes(cluster *gcp.Cluster, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if spotinst.StringValue(cluster.Name) != expectedName {
+			return fmt.Errorf("bad content: %v", cluster.Name)
+		}
+		return nil
+	}
+}
+
+func testCheckOceanGKEExists(cluster *gcp.Cluster, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderGCP.Meta().(*Client)
+		input := &gcp.ReadClusterInput{ClusterID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderGCP().ReadCluster(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.Cluster.Name) != rs.Primary.Attributes["name"] {
+			return fmt.Errorf("Cluster not found: %+v,\n %+v\n", resp.Cluster, rs.Primary.Attributes)
+		}
+		*cluster = *resp.Cluster
+		return nil
+	}
+}
+
+type OceanGKEMetadata struct {
+	clusterName          string
+	provider             string
+	fieldsToAppend       string
+	updateBaselineFields bool
+}
+
+func createOceanGKETerraform(clusterMeta *OceanGKEMetadata) string {
+	if clusterMeta == nil {
+		return ""
+	}
+
+	if clusterMeta.provider == "" {
+		clusterMeta.provider = "gcp"
+	}
+
+	template :=
+		`provider "gcp" {
+	token   = "fake"
+	account = "fake"
+	}
+	`
+	if clusterMeta.updateBaselineFields {
+		format := testBaselineOceanGKEConfig_Update
+		template += fmt.Sprintf(format,
+			clusterMeta.clusterName,
+			clusterMeta.provider,
+			clusterMeta.clusterName,
+			clusterMeta.fieldsToAppend,
+		)
+	} else {
+		format := testBaselineOceanGKEConfig_Create
+		template += fmt.Sprintf(format,
+			clusterMeta.clusterName,
+			clusterMeta.provider,
+			clusterMeta.clusterName,
+			clusterMeta.fieldsToAppend,
+		)
+	}
+
+	log.Printf("Terraform [%v] template:\n%v", clusterMeta.clusterName, template)
+	return template
+}
+
+// region Ocean GKE: Baseline
+func TestAccSpotinstOceanGKE_Baseline(t *testing.T) {
+	clusterName := "test-acc-cluster-gke-baseline"
+	resourceName := createOceanGKEResourceName(clusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName: clusterName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", GcpClusterName),
+					resource.TestCheckResourceAttr(resourceName, "master_location", "us-central1-a"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.0", "n1-standard-1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.1", "n1-standard-2"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "desired_capacity", "0"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:          clusterName,
+					updateBaselineFields: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.0", "n1-standard-1"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_policy"},
+			},
+		},
+	})
+}
+
+const testBaselineOceanGKEConfig_Create = `
+resource "` + string(commons.OceanGKEResourceName) + `" "%v" {
+ provider = "%v"
+
+ name            = "%v"
+ controller_id   = "terraform-acc-tests-gke-controller"
+ cluster_name    = "terraform-tests-do-not-delete"
+ master_location = "us-central1-a"
+
+ availability_zones = ["us-central1-a"]
+ subnet_name        = "default"
+ source_image       = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+
+ metadata {
+   key   = "gci-update-strategy"
+   value = "update_disabled"
+ }
+
+ whitelist        = ["n1-standard-1", "n1-standard-2"]
+ min_size         = 0
+ max_size         = 2
+ desired_capacity = 0
+ %v
+}
+
+`
+
+const testBaselineOceanGKEConfig_Update = `
+resource "` + string(commons.OceanGKEResourceName) + `" "%v" {
+ provider = "%v"
+
+ name            = "%v"
+ controller_id   = "terraform-acc-tests-gke-controller"
+ cluster_name    = "terraform-tests-do-not-delete"
+ master_location = "us-central1-a"
+
+ availability_zones = ["us-central1-a"]
+ subnet_name        = "default"
+ source_image       = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+
+ metadata {
+   key   = "gci-update-strategy"
+   value = "update_disabled"
+ }
+
+ whitelist        = ["n1-standard-1"]
+ min_size         = 0
+ max_size         = 3
+ desired_capacity = 0
+ %v
+}
+
+`
+
+// endregion
+
+// region Ocean GKE: Update Policy
+func TestAccSpotinstOceanGKE_UpdatePolicy(t *testing.T) {
+	clusterName := "test-acc-cluster-gke-update-policy"
+	resourceName := createOceanGKEResourceName(clusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:    clusterName,
+					fieldsToAppend: testUpdatePolicyOceanGKEConfig_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "false"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.conditioned_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "33"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:          clusterName,
+					updateBaselineFields: true,
+					fieldsToAppend:       testUpdatePolicyOceanGKEConfig_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.conditioned_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "66"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_min_healthy_percentage", "50"),
+				),
+			},
+		},
+	})
+}
+
+const testUpdatePolicyOceanGKEConfig_Create = `
+ update_policy {
+   should_roll      = false
+   conditioned_roll = true
+
+   roll_config {
+     batch_size_percentage = 33
+   }
+ }
+`
+
+const testUpdatePolicyOceanGKEConfig_Update = `
+ update_policy {
+   should_roll      = true
+   conditioned_roll = true
+
+   roll_config {
+     batch_size_percentage        = 66
+     batch_min_healthy_percentage = 50
+   }
+ }
+`
+
+// endregion
@@ -18,7 +18,6 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/azure_v3/stateful_node_azure_strategy"
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/azure_v3/stateful_node_azure_vm_sizes"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,6 +40,8 @@ func resourceSpotinstStatefulNodeAzureV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: commons.StatefulNodeAzureV3Resource.GetTimeouts(),
+
 		Schema: commons.StatefulNodeAzureV3Resource.GetSchemaMap(),
 	}
 }
@@ -80,7 +81,7 @@ func resourceSpotinstStatefulNodeAzureV3Create(ctx context.Context, resourceData
 			return diag.Errorf("stateful node/azure: failed expanding import vm configuration: %v", err)
 		}
 
-		statefulNodeId, err := createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput, meta.(*Client))
+		statefulNodeId, err := createAzureV3StatefulNodeImportVM(ctx, resourceData, importVMStatefulNodeInput, meta.(*Client))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -89,7 +90,7 @@ func resourceSpotinstStatefulNodeAzureV3Create(ctx context.Context, resourceData
 		log.Printf("===> Stateful node using import vm created successfully: %s <===", resourceData.Id())
 
 	} else {
-		statefulNodeId, err := createAzureV3StatefulNode(statefulNode, meta.(*Client))
+		statefulNodeId, err := createAzureV3StatefulNode(ctx, resourceData, statefulNode, meta.(*Client))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -132,7 +133,7 @@ func expandStatefulNodeAzureImportVMConfig(data interface{}, statefulNode *azure
 	return spec, nil
 }
 
-func createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput *azure.ImportVMStatefulNodeInput, spotinstClient *Client) (*string, error) {
+func createAzureV3StatefulNodeImportVM(ctx context.Context, resourceData *schema.ResourceData, importVMStatefulNodeInput *azure.ImportVMStatefulNodeInput, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(importVMStatefulNodeInput); err != nil {
 		return nil, err
 	} else {
@@ -140,8 +141,8 @@ func createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput *azure.ImportVM
 	}
 
 	var resp *azure.ImportVMStatefulNodeOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
-		r, err := spotinstClient.statefulNode.CloudProviderAzure().ImportVM(context.Background(), importVMStatefulNodeInput)
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		r, err := spotinstClient.statefulNode.CloudProviderAzure().ImportVM(ctx, importVMStatefulNodeInput)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -157,7 +158,7 @@ func createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput *azure.ImportVM
 	return resp.StatefulNodeImport.StatefulNode.ID, nil
 }
 
-func createAzureV3StatefulNode(statefulNode *azure.StatefulNode, spotinstClient *Client) (*string, error) {
+func createAzureV3StatefulNode(ctx context.Context, resourceData *schema.ResourceData, statefulNode *azure.StatefulNode, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(statefulNode); err != nil {
 		return nil, err
 	} else {
@@ -165,9 +166,9 @@ func createAzureV3StatefulNode(statefulNode *azure.StatefulNode, spotinstClient
 	}
 
 	var resp *azure.CreateStatefulNodeOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &azure.CreateStatefulNodeInput{StatefulNode: statefulNode}
-		r, err := spotinstClient.statefulNode.CloudProviderAzure().Create(context.Background(), input)
+		r, err := spotinstClient.statefulNode.CloudProviderAzure().Create(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -189,7 +190,7 @@ func resourceSpotinstStatefulNodeAzureV3Read(ctx context.Context, resourceData *
 		commons.StatefulNodeAzureV3Resource.GetName(), id)
 
 	input := &azure.ReadStatefulNodeInput{ID: spotinst.String(id)}
-	resp, err := meta.(*Client).statefulNode.CloudProviderAzure().Read(context.Background(), input)
+	resp, err := meta.(*Client).statefulNode.CloudProviderAzure().Read(ctx, input)
 	if err != nil {
 		// If the stateful node was not found, return nil so that we can show
 		// that the stateful node does not exist
@@ -232,7 +233,7 @@ func resourceSpotinstStatefulNodeAzureV3Update(ctx context.Context, resourceData
 
 	if shouldUpdate {
 		statefulNode.SetID(spotinst.String(id))
-		if err := updateAzureV3StatefulNode(statefulNode, resourceData, meta); err != nil {
+		if err := updateAzureV3StatefulNode(ctx, statefulNode, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -241,7 +242,7 @@ func resourceSpotinstStatefulNodeAzureV3Update(ctx context.Context, resourceData
 	return resourceSpotinstStatefulNodeAzureV3Read(ctx, resourceData, meta)
 }
 
-func updateAzureV3StatefulNode(statefulNode *azure.StatefulNode, resourceData *schema.ResourceData, meta interface{}) error {
+func updateAzureV3StatefulNode(ctx context.Context, statefulNode *azure.StatefulNode, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &azure.UpdateStatefulNodeInput{
 		StatefulNode: statefulNode,
 	}
@@ -277,10 +278,10 @@ func updateAzureV3StatefulNode(statefulNode *azure.StatefulNode, resourceData *s
 		log.Printf("===> Stateful node update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).statefulNode.CloudProviderAzure().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).statefulNode.CloudProviderAzure().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update stateful node [%v]: %v", statefulNodeId, err)
 	} else if shouldUpdateState {
-		if err := updateStateAzureV3StatefulNode(resourceData, meta); err != nil {
+		if err := updateStateAzureV3StatefulNode(ctx, resourceData, meta); err != nil {
 			log.Printf("[ERROR] Stateful node [%v] state update failed, error: %v", statefulNodeId, err)
 			return err
 		}
@@ -290,7 +291,7 @@ func updateAzureV3StatefulNode(statefulNode *azure.StatefulNode, resourceData *s
 	}
 
 	if shouldAttachDataDisk {
-		if err := attachDataDiskAzureV3StatefulNode(resourceData, meta); err != nil {
+		if err := attachDataDiskAzureV3StatefulNode(ctx, resourceData, meta); err != nil {
 			log.Printf("[ERROR] Stateful node [%v] attach data disk failed, error: %v", statefulNodeId, err)
 			return err
 		}
@@ -300,7 +301,7 @@ func updateAzureV3StatefulNode(statefulNode *azure.StatefulNode, resourceData *s
 	}
 
 	if shouldDetachDataDisk {
-		if err := detachDataDiskAzureV3StatefulNode(resourceData, meta); err != nil {
+		if err := detachDataDiskAzureV3StatefulNode(ctx, resourceData, meta); err != nil {
 			log.Printf("[ERROR] Stateful node [%v] detach data disk failed, error: %v", statefulNodeId, err)
 			return err
 		}
@@ -312,7 +313,7 @@ func updateAzureV3StatefulNode(statefulNode *azure.StatefulNode, resourceData *s
 	return nil
 }
 
-func updateStateAzureV3StatefulNode(resourceData *schema.ResourceData, meta interface{}) error {
+func updateStateAzureV3StatefulNode(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	statefulNodeID := resourceData.Id()
 
 	updateState, ok := resourceData.GetOk(string(stateful_node_azure.UpdateState))
@@ -343,7 +344,7 @@ func updateStateAzureV3StatefulNode(resourceData *schema.ResourceData, meta inte
 		log.Printf("onUpdate() -> Updating stateful node [%v] with configuration %s", statefulNodeID, updateStateJSON)
 		updateStateInput := &azure.UpdateStatefulNodeStateInput{ID: updateStateSpec.ID,
 			StatefulNodeState: updateStateSpec.StatefulNodeState}
-		if _, err = meta.(*Client).statefulNode.CloudProviderAzure().UpdateState(context.TODO(),
+		if _, err = meta.(*Client).statefulNode.CloudProviderAzure().UpdateState(ctx,
 			updateStateInput); err != nil {
 			return fmt.Errorf("onUpdate() -> State update failed for stateful node [%v], error: %v",
 				statefulNodeID, err)
@@ -354,7 +355,7 @@ func updateStateAzureV3StatefulNode(resourceData *schema.ResourceData, meta inte
 	return nil
 }
 
-func attachDataDiskAzureV3StatefulNode(resourceData *schema.ResourceData, meta interface{}) error {
+func attachDataDiskAzureV3StatefulNode(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	statefulNodeID := resourceData.Id()
 
 	attachDataDisk, ok := resourceData.GetOk(string(stateful_node_azure.AttachDataDisk))
@@ -391,7 +392,7 @@ func attachDataDiskAzureV3StatefulNode(resourceData *schema.ResourceData, meta i
 			SizeGB:                    attachDataDiskSpec.SizeGB,
 			LUN:                       attachDataDiskSpec.LUN,
 			Zone:                      attachDataDiskSpec.Zone}
-		if _, err = meta.(*Client).statefulNode.CloudProviderAzure().AttachDataDisk(context.TODO(),
+		if _, err = meta.(*Client).statefulNode.CloudProviderAzure().AttachDataDisk(ctx,
 			attachDataDiskInput); err != nil {
 			return fmt.Errorf("onUpdate() -> Attach data disk failed for stateful node [%v], error: %v",
 				statefulNodeID, err)
@@ -402,7 +403,7 @@ func attachDataDiskAzureV3StatefulNode(resourceData *schema.ResourceData, meta i
 	return nil
 }
 
-func detachDataDiskAzureV3StatefulNode(resourceData *schema.ResourceData, meta interface{}) error {
+func detachDataDiskAzureV3StatefulNode(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	statefulNodeID := resourceData.Id()
 
 	detachDataDisk, ok := resourceData.GetOk(string(stateful_node_azure.DetachDataDisk))
@@ -436,7 +437,7 @@ func detachDataDiskAzureV3StatefulNode(resourceData *schema.ResourceData, meta i
 			DataDiskName:              detachDataDiskSpec.DataDiskName,
 			DataDiskResourceGroupName: detachDataDiskSpec.DataDiskResourceGroupName,
 			ShouldDeallocate:          detachDataDiskSpec.ShouldDeallocate}
-		if _, err = meta.(*Client).statefulNode.CloudProviderAzure().DetachDataDisk(context.TODO(),
+		if _, err = meta.(*Client).statefulNode.CloudProviderAzure().DetachDataDisk(ctx,
 			detachDataDiskInput); err != nil {
 			return fmt.Errorf("onUpdate() -> detach data disk failed for stateful node [%v], error: %v",
 				statefulNodeID, err)
@@ -534,7 +535,7 @@ func resourceSpotinstStatefulNodeAzureV3Delete(ctx context.Context, resourceData
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.StatefulNodeAzureV3Resource.GetName(), id)
 
-	if err := deleteAzureV3StatefulNode(resourceData, meta); err != nil {
+	if err := deleteAzureV3StatefulNode(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -543,7 +544,7 @@ func resourceSpotinstStatefulNodeAzureV3Delete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteAzureV3StatefulNode(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteAzureV3StatefulNode(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	statefulNodeId := resourceData.Id()
 	if deleteConfig, ok := resourceData.GetOk(string(stateful_node_azure.Delete)); ok {
 		deleteStatefulNodeAzureInput, err := expandStatefulNodeAzureDeleteConfig(deleteConfig, statefulNodeId)
@@ -551,7 +552,7 @@ func deleteAzureV3StatefulNode(resourceData *schema.ResourceData, meta interface
 			return fmt.Errorf("stateful node/azure: failed expanding delete configuration: %v", err)
 		}
 
-		if _, err := meta.(*Client).statefulNode.CloudProviderAzure().Delete(context.Background(), deleteStatefulNodeAzureInput); err != nil {
+		if _, err := meta.(*Client).statefulNode.CloudProviderAzure().Delete(ctx, deleteStatefulNodeAzureInput); err != nil {
 			return fmt.Errorf("[ERROR] onDelete() -> Failed to delete stateful node: %s", err)
 		}
 
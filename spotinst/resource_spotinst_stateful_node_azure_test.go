@@ -12,6 +12,55 @@ import (
 	"testing"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_stateful_node_azure", &resource.Sweeper{
+		Name: "spotinst_stateful_node_azure",
+		F:    testSweepStatefulNodeAzure,
+	})
+}
+
+func testSweepStatefulNodeAzure(region string) error {
+	client, err := getProviderClient("azure")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	conn := client.(*Client).statefulNode.CloudProviderAzure()
+	input := &azure.ListStatefulNodesInput{}
+	if resp, err := conn.List(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of stateful nodes to sweep")
+	} else {
+		if len(resp.StatefulNodes) == 0 {
+			log.Printf("[INFO] No stateful nodes to sweep")
+		}
+		for _, node := range resp.StatefulNodes {
+			if isSweepable(node.Name) {
+				if _, err := conn.Delete(context.Background(), testSweepStatefulNodeAzureDeleteInput(node.ID)); err != nil {
+					return fmt.Errorf("unable to delete stateful node %v in sweep", spotinst.StringValue(node.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(node.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// testSweepStatefulNodeAzureDeleteInput returns the input deleting a stateful
+// node along with its VM and all of its resources.
+func testSweepStatefulNodeAzureDeleteInput(statefulNodeID *string) *azure.DeleteStatefulNodeInput {
+	return &azure.DeleteStatefulNodeInput{
+		ID: statefulNodeID,
+		DeallocationConfig: &azure.DeallocationConfig{
+			ShouldTerminateVM:          spotinst.Bool(true),
+			NetworkDeallocationConfig:  &azure.ResourceDeallocationConfig{ShouldDeallocate: spotinst.Bool(true)},
+			DiskDeallocationConfig:     &azure.ResourceDeallocationConfig{ShouldDeallocate: spotinst.Bool(true)},
+			SnapshotDeallocationConfig: &azure.ResourceDeallocationConfig{ShouldDeallocate: spotinst.Bool(true)},
+			PublicIPDeallocationConfig: &azure.ResourceDeallocationConfig{ShouldDeallocate: spotinst.Bool(true)},
+		},
+	}
+}
+
 func createStatefulNodeAzureV3ResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.StatefulNodeAzureResourceName), name)
 }
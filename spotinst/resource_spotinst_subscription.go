@@ -22,6 +22,12 @@ func resourceSpotinstSubscription() *schema.Resource {
 		ReadContext:   resourceSpotinstSubscriptionRead,
 		DeleteContext: resourceSpotinstSubscriptionDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: commons.SubscriptionResource.GetTimeouts(),
+
 		Schema: commons.SubscriptionResource.GetSchemaMap(),
 	}
 }
@@ -40,7 +46,7 @@ func resourceSpotinstSubscriptionDelete(ctx context.Context, resourceData *schem
 		commons.SubscriptionResource.GetName(), id)
 
 	input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(id)}
-	if _, err := meta.(*Client).subscription.Delete(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).subscription.Delete(ctx, input); err != nil {
 		return diag.Errorf("[ERROR] Failed to delete subscription: %s", err)
 	}
 
@@ -55,7 +61,7 @@ func resourceSpotinstSubscriptionRead(ctx context.Context, resourceData *schema.
 
 	client := meta.(*Client)
 	input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(resourceData.Id())}
-	subResponse, err := client.subscription.Read(context.Background(), input)
+	subResponse, err := client.subscription.Read(ctx, input)
 	if err != nil {
 		return diag.Errorf("[ERROR] Failed to read subscription: %s", err)
 	}
@@ -83,7 +89,7 @@ func resourceSpotinstSubscriptionCreate(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
-	subscriptionId, err := createSubscription(sub, meta.(*Client))
+	subscriptionId, err := createSubscription(ctx, sub, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,9 +100,9 @@ func resourceSpotinstSubscriptionCreate(ctx context.Context, resourceData *schem
 	return resourceSpotinstSubscriptionRead(ctx, resourceData, meta)
 }
 
-func createSubscription(subObj *subscription.Subscription, spotinstClient *Client) (*string, error) {
+func createSubscription(ctx context.Context, subObj *subscription.Subscription, spotinstClient *Client) (*string, error) {
 	input := &subscription.CreateSubscriptionInput{Subscription: subObj}
-	resp, err := spotinstClient.subscription.Create(context.Background(), input)
+	resp, err := spotinstClient.subscription.Create(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] failed to create subscription: %s", err)
 	}
@@ -115,7 +121,7 @@ func resourceSpotinstSubscriptionUpdate(ctx context.Context, resourceData *schem
 
 	if shouldUpdate {
 		sub.SetId(spotinst.String(id))
-		if err := updateSubscription(sub, resourceData, meta); err != nil {
+		if err := updateSubscription(ctx, sub, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -124,7 +130,7 @@ func resourceSpotinstSubscriptionUpdate(ctx context.Context, resourceData *schem
 	return resourceSpotinstSubscriptionRead(ctx, resourceData, meta)
 }
 
-func updateSubscription(sub *subscription.Subscription, resourceData *schema.ResourceData, meta interface{}) error {
+func updateSubscription(ctx context.Context, sub *subscription.Subscription, resourceData *schema.ResourceData, meta interface{}) error {
 	input := &subscription.UpdateSubscriptionInput{
 		Subscription: sub,
 	}
@@ -135,7 +141,7 @@ func updateSubscription(sub *subscription.Subscription, resourceData *schema.Res
 		log.Printf("===> Subscrption update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).subscription.Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).subscription.Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] failed to update subscription %s: %s", resourceData.Id(), err)
 	}
 	return nil
@@ -14,6 +14,53 @@ import (
 	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
 )
 
+func init() {
+	resource.AddTestSweepers("spotinst_subscription", &resource.Sweeper{
+		Name: "spotinst_subscription",
+		F:    testSweepSubscription,
+	})
+}
+
+func testSweepSubscription(region string) error {
+	client, err := getProviderClient("aws")
+	if err != nil {
+		return fmt.Errorf("error getting client: %v", err)
+	}
+
+	// Subscriptions have no name, they are swept with the groups they are
+	// subscribed to.
+	groupIDs := make(map[string]bool)
+	if resp, err := client.(*Client).elastigroup.CloudProviderAWS().List(context.Background(), &aws.ListGroupsInput{}); err != nil {
+		return fmt.Errorf("error getting list of groups to sweep")
+	} else {
+		for _, group := range resp.Groups {
+			if isSweepable(group.Name) {
+				groupIDs[spotinst.StringValue(group.ID)] = true
+			}
+		}
+	}
+
+	conn := client.(*Client).subscription
+	input := &subscription.ListSubscriptionsInput{}
+	if resp, err := conn.List(context.Background(), input); err != nil {
+		return fmt.Errorf("error getting list of subscriptions to sweep")
+	} else {
+		if len(resp.Subscriptions) == 0 {
+			log.Printf("[INFO] No subscriptions to sweep")
+		}
+		for _, sub := range resp.Subscriptions {
+			if groupIDs[spotinst.StringValue(sub.ResourceID)] {
+				if _, err := conn.Delete(context.Background(), &subscription.DeleteSubscriptionInput{SubscriptionID: sub.ID}); err != nil {
+					return fmt.Errorf("unable to delete subscription %v in sweep", spotinst.StringValue(sub.ID))
+				} else {
+					log.Printf("Sweeper deleted %v\n", spotinst.StringValue(sub.ID))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func createSubscriptionResourceName(name string) string {
 	return fmt.Sprintf("%v.%v", string(commons.SubscriptionResourceName), name)
 }
@@ -71,7 +118,7 @@ func TestAccSpotinstSubscription_Http(t *testing.T) {
 	subscriptionName := "subscription-http"
 	subResourceName := createSubscriptionResourceName(subscriptionName)
 
-	groupName := "eg-baseline"
+	groupName := "test-acc-eg-subscription"
 	groupResourceName := createElastigroupResourceName(groupName)
 	groupResourceId := "${" + groupResourceName + ".id}"
 	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName})
@@ -162,7 +209,7 @@ func TestAccSpotinstSubscription_Https(t *testing.T) {
 	subscriptionName := "subscription-https"
 	subResourceName := createSubscriptionResourceName(subscriptionName)
 
-	groupName := "eg-baseline"
+	groupName := "test-acc-eg-subscription"
 	groupResourceName := createElastigroupResourceName(groupName)
 	groupResourceId := "${" + groupResourceName + ".id}"
 	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName})
@@ -253,7 +300,7 @@ func TestAccSpotinstSubscription_Email(t *testing.T) {
 	subscriptionName := "subscription-email"
 	subResourceName := createSubscriptionResourceName(subscriptionName)
 
-	groupName := "eg-baseline"
+	groupName := "test-acc-eg-subscription"
 	groupResourceName := createElastigroupResourceName(groupName)
 	groupResourceId := "${" + groupResourceName + ".id}"
 	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName})
@@ -344,7 +391,7 @@ func TestAccSpotinstSubscription_EmailJson(t *testing.T) {
 	subscriptionName := "subscription-email-json"
 	subResourceName := createSubscriptionResourceName(subscriptionName)
 
-	groupName := "eg-baseline"
+	groupName := "test-acc-eg-subscription"
 	groupResourceName := createElastigroupResourceName(groupName)
 	groupResourceId := "${" + groupResourceName + ".id}"
 	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName})
@@ -0,0 +1,148 @@
+package spotinst
+
+import (
+	"errors"
+	"io"
+	stdlog "log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request is retried
+	// after a throttling or transient error.
+	DefaultMaxRetries = 5
+
+	// DefaultMaxBackoff is the default upper bound, in seconds, of the delay
+	// between two attempts.
+	DefaultMaxBackoff = 30
+)
+
+// minBackoff is the ceiling of the delay before the first retry, doubled on
+// each attempt.
+var minBackoff = time.Second
+
+// retryTransport is an http.RoundTripper that retries the requests that failed
+// because of rate limiting (429), and the idempotent ones, other than rolls,
+// that failed because of a server error (5xx) or a reset connection, with an exponential backoff
+// and full jitter between the attempts.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxBackoff time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxBackoff time.Duration) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		maxBackoff: maxBackoff,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !shouldRetryRequest(req, resp, err) {
+			return resp, err
+		}
+
+		// The body of the request has been consumed by the previous attempt,
+		// and can only be retried if it can be read again. The request of the
+		// caller must not be modified, so the next attempt is sent as a clone.
+		attemptReq = req.Clone(req.Context())
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			attemptReq.Body = body
+		}
+
+		delay := t.backoff(attempt, resp)
+		if err != nil {
+			stdlog.Printf("[DEBUG] Retrying %s %s in %s (attempt %d/%d): %v",
+				req.Method, req.URL.Path, delay, attempt+1, t.maxRetries, err)
+		} else {
+			stdlog.Printf("[DEBUG] Retrying %s %s in %s (attempt %d/%d): %s",
+				req.Method, req.URL.Path, delay, attempt+1, t.maxRetries, resp.Status)
+
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay to wait before the next attempt. The Retry-After
+// header of a throttled response is honored, otherwise the delay is picked at
+// random up to an exponentially growing ceiling.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			if delay := time.Duration(seconds) * time.Second; delay < t.maxBackoff {
+				return delay
+			}
+			return t.maxBackoff
+		}
+	}
+
+	ceiling := float64(minBackoff) * math.Pow(2, float64(attempt))
+	if ceiling > float64(t.maxBackoff) {
+		ceiling = float64(t.maxBackoff)
+	}
+	if ceiling < float64(minBackoff) {
+		return minBackoff
+	}
+	return minBackoff + time.Duration(rand.Int63n(int64(ceiling)-int64(minBackoff)+1))
+}
+
+// shouldRetryRequest reports whether the outcome of a request is a throttling
+// or transient error worth another attempt. A server or connection error may
+// come after the request was acted on, so only the idempotent requests are
+// retried then, and a create or a roll is never sent twice.
+func shouldRetryRequest(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotentRequest(req) && (errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF))
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusNotImplemented:
+		return false
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return isIdempotentRequest(req)
+	}
+	return false
+}
+
+// isIdempotentRequest reports whether sending the request twice has the same
+// effect as sending it once. Some rolls are PUT requests, and each of them
+// starts a new roll, so the roll paths are never idempotent.
+func isIdempotentRequest(req *http.Request) bool {
+	if strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/roll") {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package spotinst
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	defer func(d time.Duration) { minBackoff = d }(minBackoff)
+	minBackoff = time.Millisecond
+
+	cases := []struct {
+		name         string
+		method       string
+		path         string
+		statuses     []int
+		maxRetries   int
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			name:         "throttled then succeeded",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name:         "retries exhausted",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:   2,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 3,
+		},
+		{
+			name:         "client error not retried",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusBadRequest,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries disabled",
+			method:       http.MethodPut,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:   0,
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 1,
+		},
+		{
+			name:         "create throttled then succeeded",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "create server error not retried",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+		{
+			name:         "roll throttled then succeeded",
+			method:       http.MethodPut,
+			path:         "/aws/ec2/group/sig-12345678/roll",
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:         "roll server error not retried",
+			method:       http.MethodPut,
+			path:         "/aws/ec2/group/sig-12345678/roll",
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries:   5,
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"group":{}}` {
+					t.Errorf("attempt %d: unexpected body %q", attempts, body)
+				}
+				w.WriteHeader(tc.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			transport := newRetryTransport(http.DefaultTransport, tc.maxRetries, time.Second)
+			req, err := http.NewRequest(tc.method, server.URL+tc.path, bytes.NewBufferString(`{"group":{}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			body := req.Body
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if req.Body != body {
+				t.Errorf("expected the body of the request to be left as is")
+			}
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, resp.StatusCode)
+			}
+			if attempts != tc.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, DefaultMaxRetries, 10*time.Second)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		if delay := transport.backoff(attempt, nil); delay < minBackoff || delay > 10*time.Second {
+			t.Errorf("attempt %d: delay %s out of bounds", attempt, delay)
+		}
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+	if delay := transport.backoff(0, resp); delay != 3*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %s", delay)
+	}
+}
@@ -0,0 +1,429 @@
+package spotinst
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/WitoldSlawko/terraform-provider-spotinst/spotinst/commons"
+)
+
+// roundTripCase describes how a resource is driven through the round-trip
+// test: its sample configuration is expanded into an SDK object with the
+// onCreate handlers of its fields, serialized as it would be sent to the API,
+// and flattened back with the onRead handlers. Both states must be identical,
+// otherwise the resource shows a perpetual diff.
+type roundTripCase struct {
+	// resource is the commons resource holding the fields of the resource.
+	resource interface{}
+
+	// config overrides the generated sample configuration, by attribute path
+	// (e.g. "update_policy.roll_config.batch_size_percentage"). A nil value
+	// removes the attribute from the configuration.
+	config map[string]interface{}
+
+	// skip lists the attributes, or attribute prefixes, known not to be
+	// read back as they are configured, with the reason why.
+	skip map[string]string
+}
+
+func roundTripCases() map[string]roundTripCase {
+	return map[string]roundTripCase{
+		string(commons.ElastigroupAWSResourceName): {
+			resource: commons.ElastigroupResource,
+			config: map[string]interface{}{
+				"scheduled_task.batch_size_percentage": "50",
+				"scheduled_task.grace_period":          "300",
+				"scheduled_task.scale_target_capacity": "2",
+				"scheduled_task.scale_min_capacity":    "1",
+				"scheduled_task.scale_max_capacity":    "3",
+				"scheduled_task.adjustment":            "1",
+				"scheduled_task.adjustment_percentage": "10",
+				"scheduled_task.target_capacity":       nil,
+				"scheduled_task.min_capacity":          nil,
+				"scheduled_task.max_capacity":          nil,
+
+				"target_group_arns": []interface{}{"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg/0123456789abcdef"},
+
+				"signal.name": "INSTANCE_READY",
+
+				"network_interface.associate_public_ip_address":        nil,
+				"network_interface.device_index":                       "0",
+				"network_interface.secondary_private_ip_address_count": "1",
+			},
+		},
+		string(commons.ElastigroupAWSBeanstalkResourceName): {
+			resource: commons.ElastigroupAWSBeanstalkResource,
+			config: map[string]interface{}{
+				"maintenance": "START",
+
+				"scheduled_task.batch_size_percentage": "50",
+				"scheduled_task.grace_period":          "300",
+				"scheduled_task.scale_target_capacity": "2",
+				"scheduled_task.scale_min_capacity":    "1",
+				"scheduled_task.scale_max_capacity":    "3",
+				"scheduled_task.adjustment":            "1",
+				"scheduled_task.adjustment_percentage": "10",
+				"scheduled_task.target_capacity":       nil,
+				"scheduled_task.min_capacity":          nil,
+				"scheduled_task.max_capacity":          nil,
+			},
+		},
+		string(commons.ElastigroupGCPResourceName): {
+			resource: commons.ElastigroupGCPResource,
+			config: map[string]interface{}{
+				"backend_services.named_ports.ports": []interface{}{"80"},
+				"scheduled_task.target_capacity":     "2",
+				"scheduled_task.min_capacity":        "1",
+				"scheduled_task.max_capacity":        "3",
+				"instance_types_custom.memory_gib":   10,
+			},
+		},
+		string(commons.ElastigroupGKEResourceName): {
+			resource: commons.ElastigroupGKEResource,
+			config: map[string]interface{}{
+				"backend_services.named_ports.ports": []interface{}{"80"},
+				"scheduled_task.target_capacity":     "2",
+				"scheduled_task.min_capacity":        "1",
+				"scheduled_task.max_capacity":        "3",
+				"instance_types_custom.memory_gib":   10,
+			},
+		},
+		string(commons.ElastigroupAzureResourceName): {
+			resource: commons.ElastigroupAzureResource,
+			config: map[string]interface{}{
+				"scheduled_task.batch_size_percentage": "50",
+				"scheduled_task.grace_period":          "300",
+				"scheduled_task.scale_target_capacity": "2",
+				"scheduled_task.scale_min_capacity":    "1",
+				"scheduled_task.scale_max_capacity":    "3",
+				"scheduled_task.adjustment":            "1",
+				"scheduled_task.adjustment_percentage": "10",
+			},
+		},
+		string(commons.ElastigroupAzureV3ResourceName): {
+			resource: commons.ElastigroupAzureV3Resource,
+		},
+		string(commons.SubscriptionResourceName): {
+			resource: commons.SubscriptionResource,
+		},
+		string(commons.MRScalerAWSResourceName): {
+			resource: commons.MRScalerAWSResource,
+			config: map[string]interface{}{
+				"strategy":                     "clone",
+				"provisioning_timeout.timeout": 15,
+
+				"scheduled_task.desired_capacity": "2",
+				"scheduled_task.min_capacity":     "1",
+				"scheduled_task.max_capacity":     "3",
+			},
+		},
+		string(commons.OceanAWSResourceName): {
+			resource: commons.OceanAWSResource,
+		},
+		string(commons.OceanAWSLaunchSpecResourceName): {
+			resource: commons.OceanAWSLaunchSpecResource,
+		},
+		string(commons.OceanGKEResourceName): {
+			resource: commons.OceanGKEResource,
+			config: map[string]interface{}{
+				"backend_services.named_ports.ports": []interface{}{"80"},
+			},
+		},
+		string(commons.OceanGKEImportResourceName): {
+			resource: commons.OceanGKEImportResource,
+			config: map[string]interface{}{
+				"backend_services.named_ports.ports": []interface{}{"80"},
+			},
+		},
+		string(commons.OceanGKELaunchSpecResourceName): {
+			resource: commons.OceanGKELaunchSpecResource,
+		},
+		string(commons.OceanGKELaunchSpecImportResourceName): {
+			resource: commons.OceanGKELaunchSpecImportResource,
+		},
+		string(commons.OceanECSResourceName): {
+			resource: commons.OceanECSResource,
+		},
+		string(commons.OceanECSLaunchSpecResourceName): {
+			resource: commons.OceanECSLaunchSpecResource,
+		},
+		string(commons.OceanAKSResourceName): {
+			resource: commons.OceanAKSResource,
+			skip: map[string]string{
+				"aks_resource_group_name": "only used to import the cluster",
+			},
+		},
+		string(commons.OceanAKSVirtualNodeGroupResourceName): {
+			resource: commons.OceanAKSVirtualNodeGroupResource,
+		},
+		string(commons.MultaiBalancerResourceName): {
+			resource: commons.MultaiBalancerResource,
+		},
+		string(commons.MultaiDeploymentResourceName): {
+			resource: commons.MultaiDeploymentResource,
+		},
+		string(commons.MultaiListenerResourceName): {
+			resource: commons.MultaiListenerResource,
+		},
+		string(commons.MultaiRoutingRuleResourceName): {
+			resource: commons.MultaiRoutingRuleResource,
+		},
+		string(commons.MultaiTargetResourceName): {
+			resource: commons.MultaiTargetResource,
+		},
+		string(commons.MultaiTargetSetResourceName): {
+			resource: commons.MultaiTargetSetResource,
+			config: map[string]interface{}{
+				"protocol": "HTTP",
+			},
+		},
+		string(commons.ManagedInstanceAWSResourceName): {
+			resource: commons.ManagedInstanceResource,
+			config: map[string]interface{}{
+				"network_interface.device_index": "0",
+			},
+		},
+		string(commons.HealthCheckResourceName): {
+			resource: commons.HealthCheckResource,
+		},
+		string(commons.SuspendProcessesResourceName): {
+			resource: commons.SuspendProcessesResource,
+		},
+		string(commons.OceanAWSRollResourceName): {
+			resource: commons.OceanAWSRollResource,
+		},
+		string(commons.OceanAWSExtendedResourceDefinitionResourceName): {
+			resource: commons.OceanAWSExtendedResourceDefinitionResource,
+		},
+		string(commons.DataIntegrationResourceName): {
+			resource: commons.DataIntegrationResource,
+			skip: map[string]string{
+				"status": "only sent when the data integration is updated",
+			},
+		},
+		string(commons.StatefulNodeAzureResourceName): {
+			resource: commons.StatefulNodeAzureV3Resource,
+		},
+	}
+}
+
+func TestResources_RoundTrip(t *testing.T) {
+	cases := roundTripCases()
+
+	for name := range Provider().ResourcesMap {
+		c, ok := cases[name]
+		if !ok {
+			t.Errorf("missing round-trip test case for %s", name)
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			testRoundTrip(t, c)
+		})
+	}
+}
+
+func testRoundTrip(t *testing.T, c roundTripCase) {
+	resource := reflect.ValueOf(c.resource)
+	schemaMap := resource.MethodByName("GetSchemaMap").Call(nil)[0].Interface().(map[string]*schema.Schema)
+
+	config := roundTripConfig(schemaMap, "", c.config)
+
+	// The account is handled by the provider, not by the fields.
+	delete(config, string(commons.ResourceAccountID))
+
+	created := schema.TestResourceDataRaw(t, schemaMap, config)
+	created.SetId("round-trip")
+
+	onCreate := resource.MethodByName("OnCreate")
+	args := []reflect.Value{reflect.ValueOf(created), reflect.Zero(onCreate.Type().In(onCreate.Type().NumIn() - 1))}
+	if onCreate.Type().NumIn() == 3 {
+		// Resources built on top of an imported object get one with all its
+		// blocks set, as returned by the API.
+		args = append([]reflect.Value{roundTripImportedObject(onCreate.Type().In(0).Elem(), 0)}, args...)
+	}
+
+	out := onCreate.Call(args)
+	if err, _ := out[1].Interface().(error); err != nil {
+		t.Fatalf("onCreate failed: %v", err)
+	}
+
+	body, err := json.Marshal(out[0].Interface())
+	if err != nil {
+		t.Fatalf("failed to serialize the object: %v", err)
+	}
+	object := reflect.New(out[0].Type().Elem())
+	if err := json.Unmarshal(body, object.Interface()); err != nil {
+		t.Fatalf("failed to deserialize the object: %v", err)
+	}
+
+	// The object is read as on a refresh, on top of the state of the created
+	// resource, so handlers comparing with the prior state behave as usual.
+	read := (&schema.Resource{Schema: schemaMap}).Data(created.State())
+
+	onRead := resource.MethodByName("OnRead")
+	out = onRead.Call([]reflect.Value{object, reflect.ValueOf(read), reflect.Zero(onRead.Type().In(2))})
+	if err, _ := out[0].Interface().(error); err != nil {
+		t.Fatalf("onRead failed: %v", err)
+	}
+
+	want := roundTripAttributes(created, config, c.skip)
+	got := roundTripAttributes(read, config, c.skip)
+
+	var diffs []string
+	for key, value := range want {
+		if got[key] != value {
+			diffs = append(diffs, fmt.Sprintf("%s: configured %q, read %q", key, value, got[key]))
+		}
+	}
+	for key, value := range got {
+		if _, ok := want[key]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s: not configured, read %q", key, value))
+		}
+	}
+	sort.Strings(diffs)
+
+	if len(diffs) > 0 {
+		t.Errorf("the configuration is not read back as it was created (%s):\n%s\n\nrequest: %s",
+			"add the attribute to skip if this is expected", strings.Join(diffs, "\n"), body)
+	}
+}
+
+// roundTripImportedObject returns a new object of the given struct type with
+// all its nested structs allocated.
+func roundTripImportedObject(typ reflect.Type, depth int) reflect.Value {
+	object := reflect.New(typ)
+	if depth > 10 {
+		return object
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" || field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		object.Elem().Field(i).Set(roundTripImportedObject(field.Type.Elem(), depth+1))
+	}
+	return object
+}
+
+// roundTripAttributes returns the flattened state of the configured
+// attributes, except the skipped ones.
+func roundTripAttributes(resourceData *schema.ResourceData, config map[string]interface{}, skip map[string]string) map[string]string {
+	attributes := make(map[string]string)
+
+	for key, value := range resourceData.State().Attributes {
+		name := strings.SplitN(key, ".", 2)[0]
+		if _, ok := config[name]; !ok {
+			continue
+		}
+		if roundTripSkipped(key, skip) {
+			continue
+		}
+		// Empty values and collections are the same to Terraform.
+		if value == "" || (strings.HasSuffix(key, ".#") || strings.HasSuffix(key, ".%")) && value == "0" {
+			continue
+		}
+		attributes[key] = value
+	}
+
+	return attributes
+}
+
+func roundTripSkipped(key string, skip map[string]string) bool {
+	// Set elements are addressed by their hash, which is not meaningful in
+	// the skipped attributes.
+	parts := strings.Split(key, ".")
+	for i := range parts {
+		path := strings.Join(parts[:i+1], ".")
+		if _, ok := skip[path]; ok {
+			return true
+		}
+		if _, ok := skip[roundTripPath(path)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// roundTripPath removes the list indexes and set hashes from an attribute key.
+func roundTripPath(key string) string {
+	var parts []string
+	for _, part := range strings.Split(key, ".") {
+		if strings.Trim(part, "0123456789") == "" || part == "#" || part == "%" {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// roundTripConfig generates a sample configuration setting every
+// configurable attribute of the schema, so each field handler is exercised.
+func roundTripConfig(schemaMap map[string]*schema.Schema, prefix string, overrides map[string]interface{}) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := schemaMap[key]
+		if !s.Optional && !s.Required {
+			continue
+		}
+		path := prefix + key
+		if value, ok := overrides[path]; ok {
+			if value != nil {
+				config[key] = value
+			}
+			continue
+		}
+		if roundTripConflicts(s, config) {
+			continue
+		}
+		config[key] = roundTripValue(key, path, s, overrides)
+	}
+
+	return config
+}
+
+func roundTripConflicts(s *schema.Schema, config map[string]interface{}) bool {
+	conflicts := append(append([]string{}, s.ConflictsWith...), s.ExactlyOneOf...)
+	for _, conflict := range conflicts {
+		parts := strings.Split(conflict, ".")
+		if _, ok := config[parts[len(parts)-1]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func roundTripValue(key, path string, s *schema.Schema, overrides map[string]interface{}) interface{} {
+	switch s.Type {
+	case schema.TypeBool:
+		return true
+	case schema.TypeInt:
+		return 1
+	case schema.TypeFloat:
+		return 1.5
+	case schema.TypeString:
+		return key
+	case schema.TypeMap:
+		return map[string]interface{}{key: key}
+	case schema.TypeList, schema.TypeSet:
+		switch elem := s.Elem.(type) {
+		case *schema.Resource:
+			return []interface{}{roundTripConfig(elem.Schema, path+".", overrides)}
+		case *schema.Schema:
+			return []interface{}{roundTripValue(key, path, elem, overrides)}
+		}
+	}
+	return nil
+}
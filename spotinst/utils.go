@@ -0,0 +1,51 @@
+package spotinst
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+// sleepContext pauses the current goroutine for the given duration, or until
+// the context is cancelled, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryRollRequest wraps the error returned by a roll request for use within
+// resource.RetryContext. A roll that would bring the capacity below its
+// minimum is retried after a minute, any other error is reported as is.
+func retryRollRequest(ctx context.Context, err error) *resource.RetryError {
+	if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+		for _, err := range errs {
+			if strings.Contains(err.Code, "CANT_ROLL_CAPACITY_BELOW_MINIMUM") {
+				if err := sleepContext(ctx, time.Minute); err != nil {
+					return resource.NonRetryableError(err)
+				}
+				return resource.RetryableError(err)
+			}
+		}
+	}
+	return resource.NonRetryableError(err)
+}
+
+// doAPIRequest sends a request through the raw API client and discards the
+// response body. It is used for the endpoints the SDK does not cover yet.
+func doAPIRequest(ctx context.Context, r *client.Request, spotinstClient *Client) error {
+	resp, err := client.RequireOK(spotinstClient.api.Do(ctx, r))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}